@@ -5,7 +5,6 @@ variable "service_labels" {
     "account",
     "acm",
     "acmpca",
-    "alexaforbusiness",
     "amp",
     "amplify",
     "amplifybackend",
@@ -47,6 +46,7 @@ variable "service_labels" {
     "clouddirectory",
     "cloudformation",
     "cloudfront",
+    "cloudfrontkeyvaluestore",
     "cloudhsmv2",
     "cloudsearch",
     "cloudsearchdomain",
@@ -135,7 +135,6 @@ variable "service_labels" {
     "guardduty",
     "health",
     "healthlake",
-    "honeycode",
     "iam",
     "identitystore",
     "imagebuilder",
@@ -186,7 +185,6 @@ variable "service_labels" {
     "lookoutmetrics",
     "lookoutvision",
     "machinelearning",
-    "macie",
     "macie2",
     "managedblockchain",
     "marketplacecatalog",
@@ -208,7 +206,6 @@ variable "service_labels" {
     "migrationhubconfig",
     "migrationhubrefactorspaces",
     "migrationhubstrategy",
-    "mobile",
     "mq",
     "mturk",
     "mwaa",
@@ -11,12 +11,14 @@ import (
 	auditmanager_sdkv2 "github.com/aws/aws-sdk-go-v2/service/auditmanager"
 	cleanrooms_sdkv2 "github.com/aws/aws-sdk-go-v2/service/cleanrooms"
 	cloudcontrol_sdkv2 "github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
+	cloudfrontkeyvaluestore_sdkv2 "github.com/aws/aws-sdk-go-v2/service/cloudfrontkeyvaluestore"
 	cloudwatchlogs_sdkv2 "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
 	comprehend_sdkv2 "github.com/aws/aws-sdk-go-v2/service/comprehend"
 	computeoptimizer_sdkv2 "github.com/aws/aws-sdk-go-v2/service/computeoptimizer"
 	directoryservice_sdkv2 "github.com/aws/aws-sdk-go-v2/service/directoryservice"
 	docdbelastic_sdkv2 "github.com/aws/aws-sdk-go-v2/service/docdbelastic"
 	ec2_sdkv2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	eks_sdkv2 "github.com/aws/aws-sdk-go-v2/service/eks"
 	finspace_sdkv2 "github.com/aws/aws-sdk-go-v2/service/finspace"
 	fis_sdkv2 "github.com/aws/aws-sdk-go-v2/service/fis"
 	glacier_sdkv2 "github.com/aws/aws-sdk-go-v2/service/glacier"
@@ -49,7 +51,6 @@ import (
 	vpclattice_sdkv2 "github.com/aws/aws-sdk-go-v2/service/vpclattice"
 	xray_sdkv2 "github.com/aws/aws-sdk-go-v2/service/xray"
 	acmpca_sdkv1 "github.com/aws/aws-sdk-go/service/acmpca"
-	alexaforbusiness_sdkv1 "github.com/aws/aws-sdk-go/service/alexaforbusiness"
 	amplify_sdkv1 "github.com/aws/aws-sdk-go/service/amplify"
 	amplifybackend_sdkv1 "github.com/aws/aws-sdk-go/service/amplifybackend"
 	amplifyuibuilder_sdkv1 "github.com/aws/aws-sdk-go/service/amplifyuibuilder"
@@ -170,7 +171,6 @@ import (
 	groundstation_sdkv1 "github.com/aws/aws-sdk-go/service/groundstation"
 	guardduty_sdkv1 "github.com/aws/aws-sdk-go/service/guardduty"
 	health_sdkv1 "github.com/aws/aws-sdk-go/service/health"
-	honeycode_sdkv1 "github.com/aws/aws-sdk-go/service/honeycode"
 	iam_sdkv1 "github.com/aws/aws-sdk-go/service/iam"
 	imagebuilder_sdkv1 "github.com/aws/aws-sdk-go/service/imagebuilder"
 	inspector_sdkv1 "github.com/aws/aws-sdk-go/service/inspector"
@@ -214,7 +214,6 @@ import (
 	lookoutforvision_sdkv1 "github.com/aws/aws-sdk-go/service/lookoutforvision"
 	lookoutmetrics_sdkv1 "github.com/aws/aws-sdk-go/service/lookoutmetrics"
 	machinelearning_sdkv1 "github.com/aws/aws-sdk-go/service/machinelearning"
-	macie_sdkv1 "github.com/aws/aws-sdk-go/service/macie"
 	macie2_sdkv1 "github.com/aws/aws-sdk-go/service/macie2"
 	managedblockchain_sdkv1 "github.com/aws/aws-sdk-go/service/managedblockchain"
 	managedgrafana_sdkv1 "github.com/aws/aws-sdk-go/service/managedgrafana"
@@ -235,7 +234,6 @@ import (
 	migrationhubconfig_sdkv1 "github.com/aws/aws-sdk-go/service/migrationhubconfig"
 	migrationhubrefactorspaces_sdkv1 "github.com/aws/aws-sdk-go/service/migrationhubrefactorspaces"
 	migrationhubstrategyrecommendations_sdkv1 "github.com/aws/aws-sdk-go/service/migrationhubstrategyrecommendations"
-	mobile_sdkv1 "github.com/aws/aws-sdk-go/service/mobile"
 	mq_sdkv1 "github.com/aws/aws-sdk-go/service/mq"
 	mturk_sdkv1 "github.com/aws/aws-sdk-go/service/mturk"
 	mwaa_sdkv1 "github.com/aws/aws-sdk-go/service/mwaa"
@@ -364,10 +362,6 @@ func (c *AWSClient) AccountClient(ctx context.Context) *account_sdkv2.Client {
 	return errs.Must(client[*account_sdkv2.Client](ctx, c, names.Account))
 }
 
-func (c *AWSClient) AlexaForBusinessConn(ctx context.Context) *alexaforbusiness_sdkv1.AlexaForBusiness {
-	return errs.Must(conn[*alexaforbusiness_sdkv1.AlexaForBusiness](ctx, c, names.AlexaForBusiness))
-}
-
 func (c *AWSClient) AmplifyConn(ctx context.Context) *amplify_sdkv1.Amplify {
 	return errs.Must(conn[*amplify_sdkv1.Amplify](ctx, c, names.Amplify))
 }
@@ -524,6 +518,10 @@ func (c *AWSClient) CloudFrontConn(ctx context.Context) *cloudfront_sdkv1.CloudF
 	return errs.Must(conn[*cloudfront_sdkv1.CloudFront](ctx, c, names.CloudFront))
 }
 
+func (c *AWSClient) CloudFrontKeyValueStoreClient(ctx context.Context) *cloudfrontkeyvaluestore_sdkv2.Client {
+	return errs.Must(client[*cloudfrontkeyvaluestore_sdkv2.Client](ctx, c, names.CloudFrontKeyValueStore))
+}
+
 func (c *AWSClient) CloudHSMV2Conn(ctx context.Context) *cloudhsmv2_sdkv1.CloudHSMV2 {
 	return errs.Must(conn[*cloudhsmv2_sdkv1.CloudHSMV2](ctx, c, names.CloudHSMV2))
 }
@@ -744,6 +742,10 @@ func (c *AWSClient) EKSConn(ctx context.Context) *eks_sdkv1.EKS {
 	return errs.Must(conn[*eks_sdkv1.EKS](ctx, c, names.EKS))
 }
 
+func (c *AWSClient) EKSClient(ctx context.Context) *eks_sdkv2.Client {
+	return errs.Must(client[*eks_sdkv2.Client](ctx, c, names.EKS))
+}
+
 func (c *AWSClient) ELBConn(ctx context.Context) *elb_sdkv1.ELB {
 	return errs.Must(conn[*elb_sdkv1.ELB](ctx, c, names.ELB))
 }
@@ -872,10 +874,6 @@ func (c *AWSClient) HealthLakeClient(ctx context.Context) *healthlake_sdkv2.Clie
 	return errs.Must(client[*healthlake_sdkv2.Client](ctx, c, names.HealthLake))
 }
 
-func (c *AWSClient) HoneycodeConn(ctx context.Context) *honeycode_sdkv1.Honeycode {
-	return errs.Must(conn[*honeycode_sdkv1.Honeycode](ctx, c, names.Honeycode))
-}
-
 func (c *AWSClient) IAMConn(ctx context.Context) *iam_sdkv1.IAM {
 	return errs.Must(conn[*iam_sdkv1.IAM](ctx, c, names.IAM))
 }
@@ -1092,10 +1090,6 @@ func (c *AWSClient) MachineLearningConn(ctx context.Context) *machinelearning_sd
 	return errs.Must(conn[*machinelearning_sdkv1.MachineLearning](ctx, c, names.MachineLearning))
 }
 
-func (c *AWSClient) MacieConn(ctx context.Context) *macie_sdkv1.Macie {
-	return errs.Must(conn[*macie_sdkv1.Macie](ctx, c, names.Macie))
-}
-
 func (c *AWSClient) Macie2Conn(ctx context.Context) *macie2_sdkv1.Macie2 {
 	return errs.Must(conn[*macie2_sdkv1.Macie2](ctx, c, names.Macie2))
 }
@@ -1176,10 +1170,6 @@ func (c *AWSClient) MigrationHubStrategyConn(ctx context.Context) *migrationhubs
 	return errs.Must(conn[*migrationhubstrategyrecommendations_sdkv1.MigrationHubStrategyRecommendations](ctx, c, names.MigrationHubStrategy))
 }
 
-func (c *AWSClient) MobileConn(ctx context.Context) *mobile_sdkv1.Mobile {
-	return errs.Must(conn[*mobile_sdkv1.Mobile](ctx, c, names.Mobile))
-}
-
 func (c *AWSClient) NeptuneConn(ctx context.Context) *neptune_sdkv1.Neptune {
 	return errs.Must(conn[*neptune_sdkv1.Neptune](ctx, c, names.Neptune))
 }
@@ -231,6 +231,10 @@ func ResourceDistribution() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(0, 128),
 			},
+			"continuous_deployment_policy_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"custom_error_response": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -675,6 +679,12 @@ func ResourceDistribution() *schema.Resource {
 					},
 				},
 			},
+			"staging": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
 			"viewer_certificate": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -843,6 +853,11 @@ func resourceDistributionCreate(ctx context.Context, d *schema.ResourceData, met
 		input.DistributionConfigWithTags.Tags.Items = tags
 	}
 
+	// A continuous deployment policy can't be associated with a distribution on creation.
+	// The policy is attached once the distribution has been deployed.
+	continuousDeploymentPolicyID := aws.StringValue(input.DistributionConfigWithTags.DistributionConfig.ContinuousDeploymentPolicyId)
+	input.DistributionConfigWithTags.DistributionConfig.ContinuousDeploymentPolicyId = aws.String("")
+
 	var resp *cloudfront.CreateDistributionWithTagsOutput
 	// Handle eventual consistency issues
 	err := retry.RetryContext(ctx, 1*time.Minute, func() *retry.RetryError {
@@ -873,6 +888,29 @@ func resourceDistributionCreate(ctx context.Context, d *schema.ResourceData, met
 
 	d.SetId(aws.StringValue(resp.Distribution.Id))
 
+	if continuousDeploymentPolicyID != "" {
+		if err := DistributionWaitUntilDeployed(ctx, d.Id(), meta); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting until CloudFront Distribution (%s) is deployed: %s", d.Id(), err)
+		}
+
+		output, err := FindDistributionByID(ctx, conn, d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading CloudFront Distribution (%s): %s", d.Id(), err)
+		}
+
+		input := &cloudfront.UpdateDistributionInput{
+			DistributionConfig: output.Distribution.DistributionConfig,
+			Id:                 aws.String(d.Id()),
+			IfMatch:            output.ETag,
+		}
+		input.DistributionConfig.ContinuousDeploymentPolicyId = aws.String(continuousDeploymentPolicyID)
+
+		if _, err := conn.UpdateDistributionWithContext(ctx, input); err != nil {
+			return sdkdiag.AppendErrorf(diags, "attaching CloudFront Continuous Deployment Policy (%s) to CloudFront Distribution (%s): %s", continuousDeploymentPolicyID, d.Id(), err)
+		}
+	}
+
 	if d.Get("wait_for_deployment").(bool) {
 		log.Printf("[DEBUG] Waiting until CloudFront Distribution (%s) is deployed", d.Id())
 		if err := DistributionWaitUntilDeployed(ctx, d.Id(), meta); err != nil {
@@ -949,8 +987,9 @@ func resourceDistributionUpdate(ctx context.Context, d *schema.ResourceData, met
 		return nil
 	})
 
-	// Refresh our ETag if it is out of date and attempt update again
-	if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodePreconditionFailed) {
+	// Refresh our ETag if it is out of date and attempt update again.
+	// The ETag changes outside of Terraform when, for example, a staging distribution is promoted.
+	if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodePreconditionFailed, cloudfront.ErrCodeInvalidIfMatchVersion) {
 		getDistributionInput := &cloudfront.GetDistributionInput{
 			Id: aws.String(d.Id()),
 		}
@@ -1025,6 +1064,36 @@ func resourceDistributionDelete(ctx context.Context, d *schema.ResourceData, met
 		return diags
 	}
 
+	// A primary distribution's continuous deployment policy must be detached before the distribution is deleted.
+	if v := d.Get("continuous_deployment_policy_id").(string); v != "" {
+		output, err := FindDistributionByID(ctx, conn, d.Id())
+
+		if tfresource.NotFound(err) {
+			return diags
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading CloudFront Distribution (%s): %s", d.Id(), err)
+		}
+
+		if aws.StringValue(output.Distribution.DistributionConfig.ContinuousDeploymentPolicyId) != "" {
+			input := &cloudfront.UpdateDistributionInput{
+				DistributionConfig: output.Distribution.DistributionConfig,
+				Id:                 aws.String(d.Id()),
+				IfMatch:            output.ETag,
+			}
+			input.DistributionConfig.ContinuousDeploymentPolicyId = aws.String("")
+
+			if _, err := conn.UpdateDistributionWithContext(ctx, input); err != nil {
+				return sdkdiag.AppendErrorf(diags, "detaching CloudFront Continuous Deployment Policy (%s) from CloudFront Distribution (%s): %s", v, d.Id(), err)
+			}
+
+			if err := DistributionWaitUntilDeployed(ctx, d.Id(), meta); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting until CloudFront Distribution (%s) is deployed: %s", d.Id(), err)
+			}
+		}
+	}
+
 	deleteDistroInput := &cloudfront.DeleteDistributionInput{
 		Id:      aws.String(d.Id()),
 		IfMatch: aws.String(d.Get("etag").(string)),
@@ -1038,7 +1107,7 @@ func resourceDistributionDelete(ctx context.Context, d *schema.ResourceData, met
 	}
 
 	// Refresh our ETag if it is out of date and attempt deletion again.
-	if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeInvalidIfMatchVersion) {
+	if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeInvalidIfMatchVersion, cloudfront.ErrCodePreconditionFailed) {
 		var output *cloudfront.GetDistributionOutput
 		output, err = FindDistributionByID(ctx, conn, d.Id())
 
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 // @SDKResource("aws_cloudfront_function")
@@ -43,6 +44,15 @@ func ResourceFunction() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"key_value_store_associations": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
 			"live_stage_etag": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -84,6 +94,10 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 		Name: aws.String(functionName),
 	}
 
+	if v, ok := d.GetOk("key_value_store_associations"); ok {
+		input.FunctionConfig.KeyValueStoreAssociations = expandKeyValueStoreAssociations(v.(*schema.Set).List())
+	}
+
 	log.Printf("[DEBUG] Creating CloudFront Function: %s", functionName)
 	output, err := conn.CreateFunctionWithContext(ctx, input)
 
@@ -129,6 +143,9 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta inte
 	d.Set("arn", describeFunctionOutput.FunctionSummary.FunctionMetadata.FunctionARN)
 	d.Set("comment", describeFunctionOutput.FunctionSummary.FunctionConfig.Comment)
 	d.Set("etag", describeFunctionOutput.ETag)
+	if err := d.Set("key_value_store_associations", flattenKeyValueStoreAssociations(describeFunctionOutput.FunctionSummary.FunctionConfig.KeyValueStoreAssociations)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting key_value_store_associations: %s", err)
+	}
 	d.Set("name", describeFunctionOutput.FunctionSummary.Name)
 	d.Set("runtime", describeFunctionOutput.FunctionSummary.FunctionConfig.Runtime)
 	d.Set("status", describeFunctionOutput.FunctionSummary.Status)
@@ -162,7 +179,7 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 	conn := meta.(*conns.AWSClient).CloudFrontConn(ctx)
 	etag := d.Get("etag").(string)
 
-	if d.HasChanges("code", "comment", "runtime") {
+	if d.HasChanges("code", "comment", "key_value_store_associations", "runtime") {
 		input := &cloudfront.UpdateFunctionInput{
 			FunctionCode: []byte(d.Get("code").(string)),
 			FunctionConfig: &cloudfront.FunctionConfig{
@@ -173,6 +190,15 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			IfMatch: aws.String(etag),
 		}
 
+		if v, ok := d.GetOk("key_value_store_associations"); ok {
+			input.FunctionConfig.KeyValueStoreAssociations = expandKeyValueStoreAssociations(v.(*schema.Set).List())
+		} else {
+			// Explicitly remove any existing associations.
+			input.FunctionConfig.KeyValueStoreAssociations = &cloudfront.KeyValueStoreAssociations{
+				Quantity: aws.Int64(0),
+			}
+		}
+
 		log.Printf("[INFO] Updating CloudFront Function: %s", d.Id())
 		output, err := conn.UpdateFunctionWithContext(ctx, input)
 
@@ -220,3 +246,46 @@ func resourceFunctionDelete(ctx context.Context, d *schema.ResourceData, meta in
 
 	return diags
 }
+
+func expandKeyValueStoreAssociations(tfList []interface{}) *cloudfront.KeyValueStoreAssociations {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var items []*cloudfront.KeyValueStoreAssociation
+
+	for _, v := range tfList {
+		v, ok := v.(string)
+
+		if !ok {
+			continue
+		}
+
+		items = append(items, &cloudfront.KeyValueStoreAssociation{
+			KeyValueStoreARN: aws.String(v),
+		})
+	}
+
+	return &cloudfront.KeyValueStoreAssociations{
+		Items:    items,
+		Quantity: aws.Int64(int64(len(items))),
+	}
+}
+
+func flattenKeyValueStoreAssociations(apiObject *cloudfront.KeyValueStoreAssociations) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, v := range apiObject.Items {
+		if v == nil {
+			continue
+		}
+
+		tfList = append(tfList, aws.StringValue(v.KeyValueStoreARN))
+	}
+
+	return tfList
+}
@@ -0,0 +1,235 @@
+package cloudfrontkeyvaluestore
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfrontkeyvaluestore"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfrontkeyvaluestore/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	// Every mutation of a key value store changes its ETag, so concurrent writers retry on conflict.
+	keyConflictTimeout = 2 * time.Minute
+)
+
+// @SDKResource("aws_cloudfrontkeyvaluestore_key", name="Key")
+func ResourceKey() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceKeyPut,
+		ReadWithoutTimeout:   resourceKeyRead,
+		UpdateWithoutTimeout: resourceKeyPut,
+		DeleteWithoutTimeout: resourceKeyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"key_value_store_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"total_size_in_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"value": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceKeyPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudFrontKeyValueStoreClient(ctx)
+
+	kvsARN := d.Get("key_value_store_arn").(string)
+	key := d.Get("key").(string)
+	id := KeyCreateResourceID(kvsARN, key)
+
+	_, err := tfresource.RetryWhenIsA[*types.ConflictException](ctx, keyConflictTimeout, func() (interface{}, error) {
+		etag, err := findKeyValueStoreETagByARN(ctx, conn, kvsARN)
+
+		if err != nil {
+			return nil, err
+		}
+
+		input := &cloudfrontkeyvaluestore.PutKeyInput{
+			IfMatch: etag,
+			Key:     aws.String(key),
+			KvsARN:  aws.String(kvsARN),
+			Value:   aws.String(d.Get("value").(string)),
+		}
+
+		return conn.PutKey(ctx, input)
+	})
+
+	if err != nil {
+		return diag.Errorf("putting CloudFront KeyValueStore Key (%s): %s", id, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(id)
+	}
+
+	return resourceKeyRead(ctx, d, meta)
+}
+
+func resourceKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudFrontKeyValueStoreClient(ctx)
+
+	kvsARN, key, err := KeyParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindKeyByTwoPartKey(ctx, conn, kvsARN, key)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudFront KeyValueStore Key (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading CloudFront KeyValueStore Key (%s): %s", d.Id(), err)
+	}
+
+	d.Set("key", output.Key)
+	d.Set("key_value_store_arn", kvsARN)
+	d.Set("total_size_in_bytes", output.TotalSizeInBytes)
+	d.Set("value", output.Value)
+
+	return nil
+}
+
+func resourceKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudFrontKeyValueStoreClient(ctx)
+
+	kvsARN, key, err := KeyParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting CloudFront KeyValueStore Key: %s", d.Id())
+	_, err = tfresource.RetryWhenIsA[*types.ConflictException](ctx, keyConflictTimeout, func() (interface{}, error) {
+		etag, err := findKeyValueStoreETagByARN(ctx, conn, kvsARN)
+
+		if err != nil {
+			return nil, err
+		}
+
+		input := &cloudfrontkeyvaluestore.DeleteKeyInput{
+			IfMatch: etag,
+			Key:     aws.String(key),
+			KvsARN:  aws.String(kvsARN),
+		}
+
+		return conn.DeleteKey(ctx, input)
+	})
+
+	if tfresource.NotFound(err) || errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting CloudFront KeyValueStore Key (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindKeyByTwoPartKey(ctx context.Context, conn *cloudfrontkeyvaluestore.Client, kvsARN, key string) (*cloudfrontkeyvaluestore.GetKeyOutput, error) {
+	input := &cloudfrontkeyvaluestore.GetKeyInput{
+		Key:    aws.String(key),
+		KvsARN: aws.String(kvsARN),
+	}
+
+	output, err := conn.GetKey(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findKeyValueStoreETagByARN(ctx context.Context, conn *cloudfrontkeyvaluestore.Client, kvsARN string) (*string, error) {
+	input := &cloudfrontkeyvaluestore.DescribeKeyValueStoreInput{
+		KvsARN: aws.String(kvsARN),
+	}
+
+	output, err := conn.DescribeKeyValueStore(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ETag == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ETag, nil
+}
+
+const keyResourceIDSeparator = ","
+
+func KeyCreateResourceID(kvsARN, key string) string {
+	parts := []string{kvsARN, key}
+	id := strings.Join(parts, keyResourceIDSeparator)
+
+	return id
+}
+
+func KeyParseResourceID(id string) (string, string, error) {
+	// Keys may themselves contain the separator; ARNs never do.
+	parts := strings.SplitN(id, keyResourceIDSeparator, 2)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected KEY-VALUE-STORE-ARN%[2]sKEY", id, keyResourceIDSeparator)
+}
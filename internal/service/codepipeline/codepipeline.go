@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -90,6 +91,12 @@ func ResourcePipeline() *schema.Resource {
 					},
 				},
 			},
+			"execution_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      codepipeline.ExecutionModeSuperseded,
+				ValidateFunc: validation.StringInSlice(codepipeline.ExecutionMode_Values(), false),
+			},
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -99,6 +106,12 @@ func ResourcePipeline() *schema.Resource {
 					validation.StringMatch(regexp.MustCompile(`[A-Za-z0-9.@\-_]+`), ""),
 				),
 			},
+			"pipeline_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      codepipeline.PipelineTypeV1,
+				ValidateFunc: validation.StringInSlice(codepipeline.PipelineType_Values(), false),
+			},
 			"role_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -206,9 +219,103 @@ func ResourcePipeline() *schema.Resource {
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"trigger": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 50,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"git_configuration": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"pull_request": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 3,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"branches": gitFilterCriteriaSchema(),
+												"events": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MinItems: 1,
+													MaxItems: 3,
+													Elem: &schema.Schema{
+														Type:         schema.TypeString,
+														ValidateFunc: validation.StringInSlice(codepipeline.GitPullRequestEventType_Values(), false),
+													},
+												},
+												"file_paths": gitFilterCriteriaSchema(),
+											},
+										},
+									},
+									"push": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 3,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"branches":   gitFilterCriteriaSchema(),
+												"file_paths": gitFilterCriteriaSchema(),
+												"tags":       gitFilterCriteriaSchema(),
+											},
+										},
+									},
+									"source_action_name": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.All(
+											validation.StringLenBetween(1, 100),
+											validation.StringMatch(regexp.MustCompile(`[A-Za-z0-9.@\-_]+`), ""),
+										),
+									},
+								},
+							},
+						},
+						"provider_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(codepipeline.PipelineTriggerProviderType_Values(), false),
+						},
+					},
+				},
+			},
+			"variable": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 50,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"default_value": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1000),
+						},
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 200),
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 128),
+								validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9@\-_]+$`), ""),
+							),
+						},
+					},
+				},
+			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			pipelineValidateV2Features,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -274,9 +381,27 @@ func resourcePipelineRead(ctx context.Context, d *schema.ResourceData, meta inte
 
 	arn := aws.StringValue(metadata.PipelineArn)
 	d.Set("arn", arn)
+	if pipeline.ExecutionMode != nil {
+		d.Set("execution_mode", pipeline.ExecutionMode)
+	} else {
+		d.Set("execution_mode", codepipeline.ExecutionModeSuperseded)
+	}
 	d.Set("name", pipeline.Name)
+	if pipeline.PipelineType != nil {
+		d.Set("pipeline_type", pipeline.PipelineType)
+	} else {
+		d.Set("pipeline_type", codepipeline.PipelineTypeV1)
+	}
 	d.Set("role_arn", pipeline.RoleArn)
 
+	if err := d.Set("trigger", flattenTriggerDeclarations(pipeline.Triggers)); err != nil {
+		return diag.Errorf("setting trigger: %s", err)
+	}
+
+	if err := d.Set("variable", flattenVariableDeclarations(pipeline.Variables)); err != nil {
+		return diag.Errorf("setting variable: %s", err)
+	}
+
 	return nil
 }
 
@@ -365,6 +490,57 @@ func pipelineValidateActionProvider(i interface{}, path cty.Path) diag.Diagnosti
 	return nil
 }
 
+func gitFilterCriteriaSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"excludes": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MinItems: 1,
+					MaxItems: 8,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringLenBetween(1, 255),
+					},
+				},
+				"includes": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MinItems: 1,
+					MaxItems: 8,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringLenBetween(1, 255),
+					},
+				},
+			},
+		},
+	}
+}
+
+// pipelineValidateV2Features rejects V1 pipelines that use features only available in V2 pipelines.
+func pipelineValidateV2Features(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Get("pipeline_type").(string) != codepipeline.PipelineTypeV1 {
+		return nil
+	}
+
+	if v := d.Get("execution_mode").(string); v != codepipeline.ExecutionModeSuperseded {
+		return fmt.Errorf("execution_mode %s requires pipeline_type %s", v, codepipeline.PipelineTypeV2)
+	}
+
+	for _, k := range []string{"trigger", "variable"} {
+		if v := d.Get(k).([]interface{}); len(v) > 0 {
+			return fmt.Errorf("%s can only be set when pipeline_type is %s", k, codepipeline.PipelineTypeV2)
+		}
+	}
+
+	return nil
+}
+
 func pipelineSuppressStageActionConfigurationDiff(k, old, new string, d *schema.ResourceData) bool {
 	parts := strings.Split(k, ".")
 	parts = parts[:len(parts)-2]
@@ -419,10 +595,18 @@ func expandPipelineDeclaration(d *schema.ResourceData) (*codepipeline.PipelineDe
 		}
 	}
 
+	if v, ok := d.GetOk("execution_mode"); ok {
+		apiObject.ExecutionMode = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("name"); ok {
 		apiObject.Name = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("pipeline_type"); ok {
+		apiObject.PipelineType = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("role_arn"); ok {
 		apiObject.RoleArn = aws.String(v.(string))
 	}
@@ -431,6 +615,14 @@ func expandPipelineDeclaration(d *schema.ResourceData) (*codepipeline.PipelineDe
 		apiObject.Stages = expandStageDeclarations(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("trigger"); ok && len(v.([]interface{})) > 0 {
+		apiObject.Triggers = expandTriggerDeclarations(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("variable"); ok && len(v.([]interface{})) > 0 {
+		apiObject.Variables = expandVariableDeclarations(v.([]interface{}))
+	}
+
 	return apiObject, nil
 }
 
@@ -906,3 +1098,341 @@ func flattenOutputArtifacts(apiObjects []*codepipeline.OutputArtifact) []string
 
 	return aws.StringValueSlice(tfList)
 }
+
+func expandTriggerDeclarations(tfList []interface{}) []*codepipeline.PipelineTriggerDeclaration {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*codepipeline.PipelineTriggerDeclaration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &codepipeline.PipelineTriggerDeclaration{}
+
+		if v, ok := tfMap["git_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.GitConfiguration = expandGitConfiguration(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["provider_type"].(string); ok && v != "" {
+			apiObject.ProviderType = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandGitConfiguration(tfMap map[string]interface{}) *codepipeline.GitConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &codepipeline.GitConfiguration{}
+
+	if v, ok := tfMap["pull_request"].([]interface{}); ok && len(v) > 0 {
+		apiObject.PullRequest = expandGitPullRequestFilters(v)
+	}
+
+	if v, ok := tfMap["push"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Push = expandGitPushFilters(v)
+	}
+
+	if v, ok := tfMap["source_action_name"].(string); ok && v != "" {
+		apiObject.SourceActionName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandGitPullRequestFilters(tfList []interface{}) []*codepipeline.GitPullRequestFilter {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*codepipeline.GitPullRequestFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &codepipeline.GitPullRequestFilter{}
+
+		if v, ok := tfMap["branches"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			includes, excludes := expandGitFilterCriteria(v[0].(map[string]interface{}))
+			apiObject.Branches = &codepipeline.GitBranchFilterCriteria{Excludes: excludes, Includes: includes}
+		}
+
+		if v, ok := tfMap["events"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Events = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["file_paths"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			includes, excludes := expandGitFilterCriteria(v[0].(map[string]interface{}))
+			apiObject.FilePaths = &codepipeline.GitFilePathFilterCriteria{Excludes: excludes, Includes: includes}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandGitPushFilters(tfList []interface{}) []*codepipeline.GitPushFilter {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*codepipeline.GitPushFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &codepipeline.GitPushFilter{}
+
+		if v, ok := tfMap["branches"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			includes, excludes := expandGitFilterCriteria(v[0].(map[string]interface{}))
+			apiObject.Branches = &codepipeline.GitBranchFilterCriteria{Excludes: excludes, Includes: includes}
+		}
+
+		if v, ok := tfMap["file_paths"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			includes, excludes := expandGitFilterCriteria(v[0].(map[string]interface{}))
+			apiObject.FilePaths = &codepipeline.GitFilePathFilterCriteria{Excludes: excludes, Includes: includes}
+		}
+
+		if v, ok := tfMap["tags"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			includes, excludes := expandGitFilterCriteria(v[0].(map[string]interface{}))
+			apiObject.Tags = &codepipeline.GitTagFilterCriteria{Excludes: excludes, Includes: includes}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+// expandGitFilterCriteria returns the include and exclude patterns of a branch, file path or tag filter.
+func expandGitFilterCriteria(tfMap map[string]interface{}) ([]*string, []*string) {
+	if tfMap == nil {
+		return nil, nil
+	}
+
+	var includes, excludes []*string
+
+	if v, ok := tfMap["excludes"].([]interface{}); ok && len(v) > 0 {
+		excludes = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["includes"].([]interface{}); ok && len(v) > 0 {
+		includes = flex.ExpandStringList(v)
+	}
+
+	return includes, excludes
+}
+
+func expandVariableDeclarations(tfList []interface{}) []*codepipeline.PipelineVariableDeclaration {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*codepipeline.PipelineVariableDeclaration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &codepipeline.PipelineVariableDeclaration{}
+
+		if v, ok := tfMap["default_value"].(string); ok && v != "" {
+			apiObject.DefaultValue = aws.String(v)
+		}
+
+		if v, ok := tfMap["description"].(string); ok && v != "" {
+			apiObject.Description = aws.String(v)
+		}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenTriggerDeclarations(apiObjects []*codepipeline.PipelineTriggerDeclaration) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.GitConfiguration; v != nil {
+			tfMap["git_configuration"] = []interface{}{flattenGitConfiguration(v)}
+		}
+
+		if v := apiObject.ProviderType; v != nil {
+			tfMap["provider_type"] = aws.StringValue(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenGitConfiguration(apiObject *codepipeline.GitConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.PullRequest; len(v) > 0 {
+		tfMap["pull_request"] = flattenGitPullRequestFilters(v)
+	}
+
+	if v := apiObject.Push; len(v) > 0 {
+		tfMap["push"] = flattenGitPushFilters(v)
+	}
+
+	if v := apiObject.SourceActionName; v != nil {
+		tfMap["source_action_name"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenGitPullRequestFilters(apiObjects []*codepipeline.GitPullRequestFilter) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.Branches; v != nil {
+			tfMap["branches"] = flattenGitFilterCriteria(v.Includes, v.Excludes)
+		}
+
+		if v := apiObject.Events; len(v) > 0 {
+			tfMap["events"] = aws.StringValueSlice(v)
+		}
+
+		if v := apiObject.FilePaths; v != nil {
+			tfMap["file_paths"] = flattenGitFilterCriteria(v.Includes, v.Excludes)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenGitPushFilters(apiObjects []*codepipeline.GitPushFilter) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.Branches; v != nil {
+			tfMap["branches"] = flattenGitFilterCriteria(v.Includes, v.Excludes)
+		}
+
+		if v := apiObject.FilePaths; v != nil {
+			tfMap["file_paths"] = flattenGitFilterCriteria(v.Includes, v.Excludes)
+		}
+
+		if v := apiObject.Tags; v != nil {
+			tfMap["tags"] = flattenGitFilterCriteria(v.Includes, v.Excludes)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenGitFilterCriteria(includes, excludes []*string) []interface{} {
+	tfMap := map[string]interface{}{}
+
+	if len(excludes) > 0 {
+		tfMap["excludes"] = aws.StringValueSlice(excludes)
+	}
+
+	if len(includes) > 0 {
+		tfMap["includes"] = aws.StringValueSlice(includes)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenVariableDeclarations(apiObjects []*codepipeline.PipelineVariableDeclaration) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.DefaultValue; v != nil {
+			tfMap["default_value"] = aws.StringValue(v)
+		}
+
+		if v := apiObject.Description; v != nil {
+			tfMap["description"] = aws.StringValue(v)
+		}
+
+		if v := apiObject.Name; v != nil {
+			tfMap["name"] = aws.StringValue(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
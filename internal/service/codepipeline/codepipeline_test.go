@@ -610,6 +610,93 @@ func TestAccCodePipeline_ecr(t *testing.T) {
 	})
 }
 
+func TestAccCodePipeline_pipelineType(t *testing.T) {
+	ctx := acctest.Context(t)
+	var p1, p2 codepipeline.PipelineDeclaration
+	name := sdkacctest.RandString(10)
+	resourceName := "aws_codepipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckSupported(ctx, t)
+			acctest.PreCheckPartitionHasService(t, codestarconnections.EndpointsID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, codepipeline.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPipelineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCodePipelineConfig_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(ctx, resourceName, &p1),
+					resource.TestCheckResourceAttr(resourceName, "execution_mode", codepipeline.ExecutionModeSuperseded),
+					resource.TestCheckResourceAttr(resourceName, "pipeline_type", codepipeline.PipelineTypeV1),
+					resource.TestCheckResourceAttr(resourceName, "trigger.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "variable.#", "0"),
+				),
+			},
+			{
+				Config: testAccCodePipelineConfig_pipelineTypeV2(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(ctx, resourceName, &p2),
+					resource.TestCheckResourceAttr(resourceName, "execution_mode", codepipeline.ExecutionModeQueued),
+					resource.TestCheckResourceAttr(resourceName, "pipeline_type", codepipeline.PipelineTypeV2),
+					resource.TestCheckResourceAttr(resourceName, "trigger.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.provider_type", "CodeStarSourceConnection"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.git_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.git_configuration.0.source_action_name", "Source"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.git_configuration.0.push.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.git_configuration.0.push.0.branches.0.includes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.git_configuration.0.push.0.branches.0.includes.0", "main"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.git_configuration.0.push.0.file_paths.0.excludes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.git_configuration.0.push.0.file_paths.0.excludes.0", "docs/**"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.git_configuration.0.pull_request.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.git_configuration.0.pull_request.0.events.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.git_configuration.0.pull_request.0.events.0", "OPEN"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.git_configuration.0.pull_request.0.events.1", "UPDATED"),
+					resource.TestCheckResourceAttr(resourceName, "variable.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "variable.0.name", "test_var1"),
+					resource.TestCheckResourceAttr(resourceName, "variable.0.default_value", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "variable.0.description", "Test variable 1"),
+					resource.TestCheckResourceAttr(resourceName, "variable.1.name", "test_var2"),
+					resource.TestCheckResourceAttr(resourceName, "variable.1.default_value", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCodePipeline_pipelineTypeV1WithV2Features(t *testing.T) {
+	ctx := acctest.Context(t)
+	name := sdkacctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckSupported(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, codepipeline.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPipelineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCodePipelineConfig_pipelineTypeV1ExecutionMode(name),
+				ExpectError: regexp.MustCompile(`execution_mode QUEUED requires pipeline_type V2`),
+			},
+			{
+				Config:      testAccCodePipelineConfig_pipelineTypeV1Variable(name),
+				ExpectError: regexp.MustCompile(`variable can only be set when pipeline_type is V2`),
+			},
+		},
+	})
+}
+
 func testAccCheckPipelineExists(ctx context.Context, n string, v *codepipeline.PipelineDeclaration) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -1672,3 +1759,120 @@ resource "aws_codepipeline" "test" {
 }
 `, rName))
 }
+
+func testAccCodePipelineConfig_pipelineTypeV2(rName string) string { // nosemgrep:ci.codepipeline-in-func-name
+	return testAccCodePipelineConfig_pipelineTypeBase(rName, `
+  pipeline_type  = "V2"
+  execution_mode = "QUEUED"
+
+  trigger {
+    provider_type = "CodeStarSourceConnection"
+
+    git_configuration {
+      source_action_name = "Source"
+
+      push {
+        branches {
+          includes = ["main"]
+        }
+
+        file_paths {
+          excludes = ["docs/**"]
+        }
+      }
+
+      pull_request {
+        events = ["OPEN", "UPDATED"]
+
+        branches {
+          includes = ["main"]
+        }
+      }
+    }
+  }
+
+  variable {
+    name          = "test_var1"
+    default_value = "value1"
+    description   = "Test variable 1"
+  }
+
+  variable {
+    name = "test_var2"
+  }
+`)
+}
+
+func testAccCodePipelineConfig_pipelineTypeV1ExecutionMode(rName string) string { // nosemgrep:ci.codepipeline-in-func-name
+	return testAccCodePipelineConfig_pipelineTypeBase(rName, `
+  pipeline_type  = "V1"
+  execution_mode = "QUEUED"
+`)
+}
+
+func testAccCodePipelineConfig_pipelineTypeV1Variable(rName string) string { // nosemgrep:ci.codepipeline-in-func-name
+	return testAccCodePipelineConfig_pipelineTypeBase(rName, `
+  variable {
+    name          = "test_var1"
+    default_value = "value1"
+  }
+`)
+}
+
+func testAccCodePipelineConfig_pipelineTypeBase(rName, pipelineConfig string) string { // nosemgrep:ci.codepipeline-in-func-name
+	return acctest.ConfigCompose(
+		testAccS3DefaultBucket(rName),
+		testAccServiceIAMRole(rName),
+		fmt.Sprintf(`
+resource "aws_codepipeline" "test" {
+  name     = "test-pipeline-%[1]s"
+  role_arn = aws_iam_role.codepipeline_role.arn
+%[2]s
+  artifact_store {
+    location = aws_s3_bucket.test.bucket
+    type     = "S3"
+  }
+
+  stage {
+    name = "Source"
+
+    action {
+      name             = "Source"
+      category         = "Source"
+      owner            = "AWS"
+      provider         = "CodeStarSourceConnection"
+      version          = "1"
+      output_artifacts = ["test"]
+
+      configuration = {
+        ConnectionArn    = aws_codestarconnections_connection.test.arn
+        FullRepositoryId = "lifesum-terraform/test"
+        BranchName       = "main"
+      }
+    }
+  }
+
+  stage {
+    name = "Build"
+
+    action {
+      name            = "Build"
+      category        = "Build"
+      owner           = "AWS"
+      provider        = "CodeBuild"
+      input_artifacts = ["test"]
+      version         = "1"
+
+      configuration = {
+        ProjectName = "test"
+      }
+    }
+  }
+}
+
+resource "aws_codestarconnections_connection" "test" {
+  name          = %[1]q
+  provider_type = "GitHub"
+}
+`, rName, pipelineConfig))
+}
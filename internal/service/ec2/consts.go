@@ -285,6 +285,40 @@ func securityGroupRuleType_Values() []string {
 	}
 }
 
+const (
+	capacityReservationFleetAllocationStrategyPrioritized = "prioritized"
+)
+
+func capacityReservationFleetAllocationStrategy_Values() []string {
+	return []string{
+		capacityReservationFleetAllocationStrategyPrioritized,
+	}
+}
+
+const (
+	securityGroupRulesManagementAdditive      = "additive"
+	securityGroupRulesManagementAuthoritative = "authoritative"
+)
+
+func securityGroupRulesManagement_Values() []string {
+	return []string{
+		securityGroupRulesManagementAdditive,
+		securityGroupRulesManagementAuthoritative,
+	}
+}
+
+const (
+	instanceReplacementStrategyReplace           = "replace"
+	instanceReplacementStrategyReplaceRootVolume = "replace_root_volume"
+)
+
+func instanceReplacementStrategy_Values() []string {
+	return []string{
+		instanceReplacementStrategyReplace,
+		instanceReplacementStrategyReplaceRootVolume,
+	}
+}
+
 const (
 	ResInstance      = "Instance"
 	ResInstanceState = "Instance State"
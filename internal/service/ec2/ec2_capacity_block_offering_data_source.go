@@ -0,0 +1,133 @@
+package ec2
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKDataSource("aws_ec2_capacity_block_offering")
+func DataSourceCapacityBlockOffering() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceCapacityBlockOfferingRead,
+
+		Schema: map[string]*schema.Schema{
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"capacity_block_offering_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"capacity_duration_hours": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"currency_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"end_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"end_date_range": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"instance_count": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"instance_type": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"start_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"start_date_range": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"tenancy": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"upfront_fee": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceCapacityBlockOfferingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	input := &ec2.DescribeCapacityBlockOfferingsInput{
+		CapacityDurationHours: aws.Int64(int64(d.Get("capacity_duration_hours").(int))),
+		InstanceCount:         aws.Int64(int64(d.Get("instance_count").(int))),
+		InstanceType:          aws.String(d.Get("instance_type").(string)),
+	}
+
+	if v, ok := d.GetOk("end_date_range"); ok {
+		v, _ := time.Parse(time.RFC3339, v.(string))
+
+		input.EndDateRange = aws.Time(v)
+	}
+
+	if v, ok := d.GetOk("start_date_range"); ok {
+		v, _ := time.Parse(time.RFC3339, v.(string))
+
+		input.StartDateRange = aws.Time(v)
+	}
+
+	output, err := FindCapacityBlockOfferings(ctx, conn, input)
+
+	if err == nil && len(output) == 0 {
+		err = tfresource.NewEmptyResultError(input)
+	}
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("EC2 Capacity Block Offering", err))
+	}
+
+	// Use the first matching offering.
+	offering := output[0]
+
+	d.SetId(aws.StringValue(offering.CapacityBlockOfferingId))
+	d.Set("availability_zone", offering.AvailabilityZone)
+	d.Set("capacity_block_offering_id", offering.CapacityBlockOfferingId)
+	d.Set("capacity_duration_hours", offering.CapacityBlockDurationHours)
+	d.Set("currency_code", offering.CurrencyCode)
+	if offering.EndDate != nil {
+		d.Set("end_date", aws.TimeValue(offering.EndDate).Format(time.RFC3339))
+	} else {
+		d.Set("end_date", nil)
+	}
+	d.Set("instance_count", offering.InstanceCount)
+	d.Set("instance_type", offering.InstanceType)
+	if offering.StartDate != nil {
+		d.Set("start_date", aws.TimeValue(offering.StartDate).Format(time.RFC3339))
+	} else {
+		d.Set("start_date", nil)
+	}
+	d.Set("tenancy", offering.Tenancy)
+	d.Set("upfront_fee", offering.UpfrontFee)
+
+	return diags
+}
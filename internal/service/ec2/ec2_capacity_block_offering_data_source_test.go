@@ -0,0 +1,46 @@
+package ec2_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccEC2CapacityBlockOfferingDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ec2_capacity_block_offering.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckCapacityReservation(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapacityBlockOfferingDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "availability_zone"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "capacity_block_offering_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "capacity_duration_hours", "24"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "currency_code"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "end_date"),
+					resource.TestCheckResourceAttr(dataSourceName, "instance_count", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "instance_type", "p4d.24xlarge"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "start_date"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "tenancy"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "upfront_fee"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCapacityBlockOfferingDataSourceConfig_basic = `
+data "aws_ec2_capacity_block_offering" "test" {
+  capacity_duration_hours = 24
+  instance_count          = 1
+  instance_type           = "p4d.24xlarge"
+}
+`
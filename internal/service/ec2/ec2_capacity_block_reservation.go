@@ -0,0 +1,191 @@
+package ec2
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_ec2_capacity_block_reservation", name="Capacity Block Reservation")
+// @Tags(identifierAttribute="id")
+func ResourceCapacityBlockReservation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCapacityBlockReservationCreate,
+		ReadWithoutTimeout:   resourceCapacityBlockReservationRead,
+		UpdateWithoutTimeout: resourceCapacityBlockReservationUpdate,
+		DeleteWithoutTimeout: resourceCapacityBlockReservationDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(40 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"capacity_block_offering_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"created_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ebs_optimized": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"end_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"end_date_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"instance_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"instance_platform": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(ec2.CapacityReservationInstancePlatform_Values(), false),
+			},
+			"instance_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"outpost_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"placement_group_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"reservation_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"start_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"tenancy": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCapacityBlockReservationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	input := &ec2.PurchaseCapacityBlockInput{
+		CapacityBlockOfferingId: aws.String(d.Get("capacity_block_offering_id").(string)),
+		InstancePlatform:        aws.String(d.Get("instance_platform").(string)),
+		TagSpecifications:       getTagSpecificationsIn(ctx, ec2.ResourceTypeCapacityReservation),
+	}
+
+	output, err := conn.PurchaseCapacityBlockWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "purchasing EC2 Capacity Block (%s): %s", aws.StringValue(input.CapacityBlockOfferingId), err)
+	}
+
+	d.SetId(aws.StringValue(output.CapacityReservation.CapacityReservationId))
+
+	if _, err := WaitCapacityBlockReservationActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EC2 Capacity Block Reservation (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceCapacityBlockReservationRead(ctx, d, meta)...)
+}
+
+func resourceCapacityBlockReservationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	reservation, err := FindCapacityReservationByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Capacity Block Reservation %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Capacity Block Reservation (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", reservation.CapacityReservationArn)
+	d.Set("availability_zone", reservation.AvailabilityZone)
+	if reservation.CreateDate != nil {
+		d.Set("created_date", aws.TimeValue(reservation.CreateDate).Format(time.RFC3339))
+	} else {
+		d.Set("created_date", nil)
+	}
+	d.Set("ebs_optimized", reservation.EbsOptimized)
+	if reservation.EndDate != nil {
+		d.Set("end_date", aws.TimeValue(reservation.EndDate).Format(time.RFC3339))
+	} else {
+		d.Set("end_date", nil)
+	}
+	d.Set("end_date_type", reservation.EndDateType)
+	d.Set("instance_count", reservation.TotalInstanceCount)
+	d.Set("instance_platform", reservation.InstancePlatform)
+	d.Set("instance_type", reservation.InstanceType)
+	d.Set("outpost_arn", reservation.OutpostArn)
+	d.Set("placement_group_arn", reservation.PlacementGroupArn)
+	d.Set("reservation_type", reservation.ReservationType)
+	if reservation.StartDate != nil {
+		d.Set("start_date", aws.TimeValue(reservation.StartDate).Format(time.RFC3339))
+	} else {
+		d.Set("start_date", nil)
+	}
+	d.Set("tenancy", reservation.Tenancy)
+
+	setTagsOut(ctx, reservation.Tags)
+
+	return diags
+}
+
+func resourceCapacityBlockReservationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Tags only.
+	return resourceCapacityBlockReservationRead(ctx, d, meta)
+}
+
+func resourceCapacityBlockReservationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Capacity Blocks cannot be cancelled. They expire at their end date.
+	log.Printf("[WARN] EC2 Capacity Block Reservation (%s) cannot be cancelled, removing from state only", d.Id())
+
+	return diags
+}
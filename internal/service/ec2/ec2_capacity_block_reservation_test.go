@@ -0,0 +1,69 @@
+package ec2_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+)
+
+func TestAccEC2CapacityBlockReservation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	// Purchasing a Capacity Block incurs an upfront fee that cannot be refunded.
+	envvar.SkipIfEmpty(t, "EC2_CAPACITY_BLOCK_PURCHASE", "set to any value to purchase an EC2 Capacity Block")
+	var reservation ec2.CapacityReservation
+	resourceName := "aws_ec2_capacity_block_reservation.test"
+	dataSourceName := "data.aws_ec2_capacity_block_offering.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckCapacityReservation(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		// Capacity Blocks cannot be cancelled, so there is nothing to check on destroy.
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapacityBlockReservationConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCapacityReservationExists(ctx, resourceName, &reservation),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ec2", regexp.MustCompile(`capacity-reservation/cr-.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "availability_zone", dataSourceName, "availability_zone"),
+					resource.TestCheckResourceAttrPair(resourceName, "capacity_block_offering_id", dataSourceName, "capacity_block_offering_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "end_date", dataSourceName, "end_date"),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_count", dataSourceName, "instance_count"),
+					resource.TestCheckResourceAttr(resourceName, "instance_platform", "Linux/UNIX"),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_type", dataSourceName, "instance_type"),
+					resource.TestCheckResourceAttr(resourceName, "reservation_type", "capacity-block"),
+					resource.TestCheckResourceAttrPair(resourceName, "start_date", dataSourceName, "start_date"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCapacityBlockReservationConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+data "aws_ec2_capacity_block_offering" "test" {
+  capacity_duration_hours = 24
+  instance_count          = 1
+  instance_type           = "p4d.24xlarge"
+}
+
+resource "aws_ec2_capacity_block_reservation" "test" {
+  capacity_block_offering_id = data.aws_ec2_capacity_block_offering.test.capacity_block_offering_id
+  instance_platform          = "Linux/UNIX"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
@@ -0,0 +1,108 @@
+package ec2
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKResource("aws_ec2_image_block_public_access")
+func ResourceImageBlockPublicAccess() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceImageBlockPublicAccessPut,
+		ReadWithoutTimeout:   resourceImageBlockPublicAccessRead,
+		UpdateWithoutTimeout: resourceImageBlockPublicAccessPut,
+		DeleteWithoutTimeout: resourceImageBlockPublicAccessDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"state": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(append(ec2.ImageBlockPublicAccessEnabledState_Values(), ec2.ImageBlockPublicAccessDisabledState_Values()...), false),
+			},
+		},
+	}
+}
+
+func resourceImageBlockPublicAccessPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+
+	state := d.Get("state").(string)
+	if err := setImageBlockPublicAccessState(ctx, conn, state, timeout); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting EC2 Image Block Public Access (%s): %s", state, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(meta.(*conns.AWSClient).Region)
+	}
+
+	return append(diags, resourceImageBlockPublicAccessRead(ctx, d, meta)...)
+}
+
+func resourceImageBlockPublicAccessRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	output, err := FindImageBlockPublicAccessState(ctx, conn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Image Block Public Access: %s", err)
+	}
+
+	d.Set("state", output)
+
+	return diags
+}
+
+func resourceImageBlockPublicAccessDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	// Removing the resource unblocks public sharing of AMIs.
+	if err := setImageBlockPublicAccessState(ctx, conn, ec2.ImageBlockPublicAccessDisabledStateUnblocked, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "disabling EC2 Image Block Public Access: %s", err)
+	}
+
+	return diags
+}
+
+func setImageBlockPublicAccessState(ctx context.Context, conn *ec2.EC2, state string, timeout time.Duration) error {
+	var err error
+
+	if state == ec2.ImageBlockPublicAccessDisabledStateUnblocked {
+		_, err = conn.DisableImageBlockPublicAccessWithContext(ctx, &ec2.DisableImageBlockPublicAccessInput{})
+	} else {
+		_, err = conn.EnableImageBlockPublicAccessWithContext(ctx, &ec2.EnableImageBlockPublicAccessInput{
+			ImageBlockPublicAccessState: aws.String(state),
+		})
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return WaitImageBlockPublicAccessState(ctx, conn, state, timeout)
+}
@@ -0,0 +1,38 @@
+package ec2_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccImageBlockPublicAccessDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ec2_image_block_public_access.test"
+	resourceName := "aws_ec2_image_block_public_access.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckImageBlockPublicAccessDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImageBlockPublicAccessDataSourceConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "state", resourceName, "state"),
+				),
+			},
+		},
+	})
+}
+
+func testAccImageBlockPublicAccessDataSourceConfig_basic() string {
+	return acctest.ConfigCompose(testAccImageBlockPublicAccessConfig_basic("block-new-sharing"), `
+data "aws_ec2_image_block_public_access" "test" {
+  depends_on = [aws_ec2_image_block_public_access.test]
+}
+`)
+}
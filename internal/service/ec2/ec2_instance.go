@@ -60,7 +60,6 @@ func ResourceInstance() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"ami": {
 				Type:         schema.TypeString,
-				ForceNew:     true,
 				Computed:     true,
 				Optional:     true,
 				AtLeastOneOf: []string{"ami", "launch_template"},
@@ -672,6 +671,12 @@ func ResourceInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"replacement_strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      instanceReplacementStrategyReplace,
+				ValidateFunc: validation.StringInSlice(instanceReplacementStrategy_Values(), false),
+			},
 			"root_block_device": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -890,6 +895,9 @@ func ResourceInstance() *schema.Resource {
 			customdiff.ComputedIf("launch_template.0.name", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				return diff.HasChange("launch_template.0.id")
 			}),
+			customdiff.ForceNewIf("ami", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.Get("replacement_strategy").(string) != instanceReplacementStrategyReplaceRootVolume
+			}),
 			customdiff.ForceNewIf("user_data", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				return diff.Get("user_data_replace_on_change").(bool)
 			}),
@@ -1722,6 +1730,13 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	// Replace the root volume after any user data change so that the new volume is first booted with the new user data.
+	if d.HasChange("ami") && !d.IsNewResource() {
+		if err := replaceInstanceRootVolume(ctx, conn, d.Id(), d.Get("ami").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s) AMI: %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("disable_api_stop") && !d.IsNewResource() {
 		if err := disableInstanceAPIStop(ctx, conn, d.Id(), d.Get("disable_api_stop").(bool)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s): %s", d.Id(), err)
@@ -2094,6 +2109,32 @@ func modifyInstanceAttributeWithStopStart(ctx context.Context, conn *ec2.EC2, in
 	return nil
 }
 
+// replaceInstanceRootVolume restores the root volume of an EC2 instance from the specified AMI.
+// The instance is restarted by EC2 but keeps its instance ID, network interfaces and private IP addresses.
+// Reference: https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/replace-root.html
+func replaceInstanceRootVolume(ctx context.Context, conn *ec2.EC2, id, imageID string, timeout time.Duration) error {
+	input := &ec2.CreateReplaceRootVolumeTaskInput{
+		DeleteReplacedRootVolume: aws.Bool(true),
+		ImageId:                  aws.String(imageID),
+		InstanceId:               aws.String(id),
+	}
+
+	log.Printf("[INFO] Replacing EC2 Instance (%s) root volume: %s", id, input)
+	output, err := conn.CreateReplaceRootVolumeTaskWithContext(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("creating replace root volume task: %w", err)
+	}
+
+	taskID := aws.StringValue(output.ReplaceRootVolumeTask.ReplaceRootVolumeTaskId)
+
+	if _, err := WaitReplaceRootVolumeTaskSucceeded(ctx, conn, taskID, timeout); err != nil {
+		return fmt.Errorf("waiting for replace root volume task (%s): %w", taskID, err)
+	}
+
+	return nil
+}
+
 func readBlockDevices(ctx context.Context, d *schema.ResourceData, instance *ec2.Instance, conn *ec2.EC2) error {
 	ibds, err := readBlockDevicesFromInstance(ctx, d, instance, conn)
 	if err != nil {
@@ -0,0 +1,52 @@
+package ec2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_ec2_instance_metadata_defaults")
+func DataSourceInstanceMetadataDefaults() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceInstanceMetadataDefaultsRead,
+
+		Schema: map[string]*schema.Schema{
+			"http_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"http_put_response_hop_limit": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"http_tokens": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"instance_metadata_tags": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceInstanceMetadataDefaultsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	output, err := FindInstanceMetadataDefaults(ctx, conn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Instance Metadata Defaults: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	setInstanceMetadataDefaults(d, output)
+
+	return diags
+}
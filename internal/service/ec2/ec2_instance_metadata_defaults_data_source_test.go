@@ -0,0 +1,41 @@
+package ec2_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccInstanceMetadataDefaultsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ec2_instance_metadata_defaults.test"
+	resourceName := "aws_ec2_instance_metadata_defaults.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceMetadataDefaultsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceMetadataDefaultsDataSourceConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "http_endpoint", resourceName, "http_endpoint"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "http_put_response_hop_limit", resourceName, "http_put_response_hop_limit"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "http_tokens", resourceName, "http_tokens"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "instance_metadata_tags", resourceName, "instance_metadata_tags"),
+				),
+			},
+		},
+	})
+}
+
+func testAccInstanceMetadataDefaultsDataSourceConfig_basic() string {
+	return acctest.ConfigCompose(testAccInstanceMetadataDefaultsConfig_basic("required", 2), `
+data "aws_ec2_instance_metadata_defaults" "test" {
+  depends_on = [aws_ec2_instance_metadata_defaults.test]
+}
+`)
+}
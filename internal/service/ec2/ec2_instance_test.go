@@ -2074,6 +2074,59 @@ func TestAccEC2Instance_changeInstanceType(t *testing.T) {
 	})
 }
 
+func TestAccEC2Instance_replacementStrategyReplaceRootVolume(t *testing.T) {
+	ctx := acctest.Context(t)
+	var before ec2.Instance
+	var after ec2.Instance
+	resourceName := "aws_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	hash := sha1.Sum([]byte("hello world"))
+	expectedUserData := hex.EncodeToString(hash[:])
+	hash2 := sha1.Sum([]byte("new world"))
+	expectedUserDataUpdated := hex.EncodeToString(hash2[:])
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_replacementStrategy(rName, "data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id", "hello world"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &before),
+					resource.TestCheckResourceAttrPair(resourceName, "ami", "data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64", "id"),
+					resource.TestCheckResourceAttr(resourceName, "replacement_strategy", "replace_root_volume"),
+					resource.TestCheckResourceAttr(resourceName, "user_data", expectedUserData),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"replacement_strategy", "user_data_replace_on_change"},
+			},
+			{
+				Config: testAccInstanceConfig_replacementStrategy(rName, "data.aws_ami.amzn-linux-2023-ami.id", "new world"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &after),
+					testAccCheckInstanceNotRecreated(&before, &after),
+					resource.TestCheckResourceAttrPair(resourceName, "ami", "data.aws_ami.amzn-linux-2023-ami", "id"),
+					resource.TestCheckResourceAttr(resourceName, "user_data", expectedUserDataUpdated),
+					func(*terraform.State) error {
+						if o, n := aws.StringValue(before.PrivateIpAddress), aws.StringValue(after.PrivateIpAddress); o != n {
+							return fmt.Errorf("EC2 Instance private IP address changed from %s to %s", o, n)
+						}
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func TestAccEC2Instance_changeInstanceTypeAndUserData(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v ec2.Instance
@@ -6002,6 +6055,26 @@ resource "aws_instance" "test" {
 `, rName))
 }
 
+func testAccInstanceConfig_replacementStrategy(rName, amiIDRef, userData string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
+		testAccLatestAmazonLinux2023AMIConfig(),
+		testAccInstanceVPCConfig(rName, false, 0),
+		fmt.Sprintf(`
+resource "aws_instance" "test" {
+  ami                  = %[2]s
+  instance_type        = "t3.micro"
+  replacement_strategy = "replace_root_volume"
+  subnet_id            = aws_subnet.test.id
+  user_data            = %[3]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, amiIDRef, userData))
+}
+
 func testAccInstanceConfig_updateType(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLatestAmazonLinuxHVMEBSAMI(),
@@ -24,6 +24,7 @@ const (
 	errCodeInvalidAllocationIDNotFound                       = "InvalidAllocationID.NotFound"
 	errCodeInvalidAssociationIDNotFound                      = "InvalidAssociationID.NotFound"
 	errCodeInvalidAttachmentIDNotFound                       = "InvalidAttachmentID.NotFound"
+	errCodeInvalidCapacityReservationFleetIdNotFound         = "InvalidCapacityReservationFleetId.NotFound"
 	errCodeInvalidCapacityReservationIdNotFound              = "InvalidCapacityReservationId.NotFound'"
 	errCodeInvalidCarrierGatewayIDNotFound                   = "InvalidCarrierGatewayID.NotFound"
 	errCodeInvalidClientVPNActiveAssociationNotFound         = "InvalidClientVpnActiveAssociationNotFound"
@@ -95,7 +96,12 @@ const (
 	errCodeInvalidTransitGatewayPolicyTableIdNotFound        = "InvalidTransitGatewayPolicyTableId.NotFound"
 	errCodeInvalidTransitGatewayIDNotFound                   = "InvalidTransitGatewayID.NotFound"
 	errCodeInvalidTransitGatewayMulticastDomainIdNotFound    = "InvalidTransitGatewayMulticastDomainId.NotFound"
+	errCodeInvalidVerifiedAccessEndpointIdNotFound           = "InvalidVerifiedAccessEndpointId.NotFound"
+	errCodeInvalidVerifiedAccessGroupIdNotFound              = "InvalidVerifiedAccessGroupId.NotFound"
+	errCodeInvalidVerifiedAccessInstanceIdNotFound           = "InvalidVerifiedAccessInstanceId.NotFound"
+	errCodeInvalidVerifiedAccessTrustProviderIdNotFound      = "InvalidVerifiedAccessTrustProviderId.NotFound"
 	errCodeInvalidVolumeNotFound                             = "InvalidVolume.NotFound"
+	errCodeInvalidVPCBlockPublicAccessExclusionIdNotFound    = "InvalidVpcBlockPublicAccessExclusionId.NotFound"
 	errCodeInvalidVPCCIDRBlockAssociationIDNotFound          = "InvalidVpcCidrBlockAssociationID.NotFound"
 	errCodeInvalidVPCEndpointIdNotFound                      = "InvalidVpcEndpointId.NotFound"
 	errCodeInvalidVPCEndpointNotFound                        = "InvalidVpcEndpoint.NotFound"
@@ -116,6 +122,26 @@ const (
 	errCodeVPNGatewayLimitExceeded                           = "VpnGatewayLimitExceeded"
 )
 
+func CancelCapacityReservationFleetError(apiObject *ec2.FailedCapacityReservationFleetCancellationResult) error {
+	if apiObject == nil || apiObject.CancelCapacityReservationFleetError == nil {
+		return nil
+	}
+
+	return awserr.New(aws.StringValue(apiObject.CancelCapacityReservationFleetError.Code), aws.StringValue(apiObject.CancelCapacityReservationFleetError.Message), nil)
+}
+
+func CancelCapacityReservationFleetsError(apiObjects []*ec2.FailedCapacityReservationFleetCancellationResult) error {
+	var errors *multierror.Error
+
+	for _, apiObject := range apiObjects {
+		if err := CancelCapacityReservationFleetError(apiObject); err != nil {
+			errors = multierror.Append(errors, fmt.Errorf("%s: %w", aws.StringValue(apiObject.CapacityReservationFleetId), err))
+		}
+	}
+
+	return errors.ErrorOrNil()
+}
+
 func CancelSpotFleetRequestError(apiObject *ec2.CancelSpotFleetRequestsErrorItem) error {
 	if apiObject == nil || apiObject.Error == nil {
 		return nil
@@ -51,6 +51,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: "id",
 			},
 		},
+		{
+			Factory: newResourceSecurityGroupRulesExclusive,
+			Name:    "Security Group Rules Exclusive",
+		},
 	}
 }
 
@@ -100,6 +104,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceEBSVolumes,
 			TypeName: "aws_ebs_volumes",
 		},
+		{
+			Factory:  DataSourceCapacityBlockOffering,
+			TypeName: "aws_ec2_capacity_block_offering",
+		},
 		{
 			Factory:  DataSourceClientVPNEndpoint,
 			TypeName: "aws_ec2_client_vpn_endpoint",
@@ -116,6 +124,14 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceHost,
 			TypeName: "aws_ec2_host",
 		},
+		{
+			Factory:  DataSourceImageBlockPublicAccess,
+			TypeName: "aws_ec2_image_block_public_access",
+		},
+		{
+			Factory:  DataSourceInstanceMetadataDefaults,
+			TypeName: "aws_ec2_instance_metadata_defaults",
+		},
 		{
 			Factory:  DataSourceInstanceType,
 			TypeName: "aws_ec2_instance_type",
@@ -513,6 +529,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceAvailabilityZoneGroup,
 			TypeName: "aws_ec2_availability_zone_group",
 		},
+		{
+			Factory:  ResourceCapacityBlockReservation,
+			TypeName: "aws_ec2_capacity_block_reservation",
+			Name:     "Capacity Block Reservation",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+			},
+		},
 		{
 			Factory:  ResourceCapacityReservation,
 			TypeName: "aws_ec2_capacity_reservation",
@@ -521,6 +545,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "id",
 			},
 		},
+		{
+			Factory:  ResourceCapacityReservationFleet,
+			TypeName: "aws_ec2_capacity_reservation_fleet",
+			Name:     "Capacity Reservation Fleet",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+			},
+		},
 		{
 			Factory:  ResourceCarrierGateway,
 			TypeName: "aws_ec2_carrier_gateway",
@@ -565,6 +597,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "id",
 			},
 		},
+		{
+			Factory:  ResourceImageBlockPublicAccess,
+			TypeName: "aws_ec2_image_block_public_access",
+		},
+		{
+			Factory:  ResourceInstanceMetadataDefaults,
+			TypeName: "aws_ec2_instance_metadata_defaults",
+		},
 		{
 			Factory:  ResourceInstanceState,
 			TypeName: "aws_ec2_instance_state",
@@ -937,6 +977,48 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "id",
 			},
 		},
+		{
+			Factory:  ResourceVerifiedAccessEndpoint,
+			TypeName: "aws_verifiedaccess_endpoint",
+			Name:     "Verified Access Endpoint",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+			},
+		},
+		{
+			Factory:  ResourceVerifiedAccessGroup,
+			TypeName: "aws_verifiedaccess_group",
+			Name:     "Verified Access Group",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+			},
+		},
+		{
+			Factory:  ResourceVerifiedAccessInstance,
+			TypeName: "aws_verifiedaccess_instance",
+			Name:     "Verified Access Instance",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+			},
+		},
+		{
+			Factory:  ResourceVerifiedAccessInstanceLoggingConfiguration,
+			TypeName: "aws_verifiedaccess_instance_logging_configuration",
+			Name:     "Verified Access Instance Logging Configuration",
+		},
+		{
+			Factory:  ResourceVerifiedAccessInstanceTrustProviderAttachment,
+			TypeName: "aws_verifiedaccess_instance_trust_provider_attachment",
+			Name:     "Verified Access Instance Trust Provider Attachment",
+		},
+		{
+			Factory:  ResourceVerifiedAccessTrustProvider,
+			TypeName: "aws_verifiedaccess_trust_provider",
+			Name:     "Verified Access Trust Provider",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+			},
+		},
 		{
 			Factory:  ResourceVolumeAttachment,
 			TypeName: "aws_volume_attachment",
@@ -949,6 +1031,19 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "id",
 			},
 		},
+		{
+			Factory:  ResourceVPCBlockPublicAccessExclusion,
+			TypeName: "aws_vpc_block_public_access_exclusion",
+			Name:     "VPC Block Public Access Exclusion",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+			},
+		},
+		{
+			Factory:  ResourceVPCBlockPublicAccessOptions,
+			TypeName: "aws_vpc_block_public_access_options",
+			Name:     "VPC Block Public Access Options",
+		},
 		{
 			Factory:  ResourceVPCDHCPOptions,
 			TypeName: "aws_vpc_dhcp_options",
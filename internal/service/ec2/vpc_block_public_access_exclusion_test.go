@@ -0,0 +1,255 @@
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccVPCBlockPublicAccessExclusion_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.VpcBlockPublicAccessExclusion
+	resourceName := "aws_vpc_block_public_access_exclusion.test"
+	vpcResourceName := "aws_vpc.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCBlockPublicAccessExclusionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCBlockPublicAccessExclusionConfig_vpc(rName, "allow-bidirectional"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCBlockPublicAccessExclusionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "internet_gateway_exclusion_mode", "allow-bidirectional"),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_arn", vpcResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "subnet_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_id", vpcResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVPCBlockPublicAccessExclusionConfig_vpc(rName, "allow-egress"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCBlockPublicAccessExclusionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "internet_gateway_exclusion_mode", "allow-egress"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCBlockPublicAccessExclusion_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.VpcBlockPublicAccessExclusion
+	resourceName := "aws_vpc_block_public_access_exclusion.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCBlockPublicAccessExclusionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCBlockPublicAccessExclusionConfig_vpc(rName, "allow-bidirectional"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCBlockPublicAccessExclusionExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfec2.ResourceVPCBlockPublicAccessExclusion(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCBlockPublicAccessExclusion_subnet(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.VpcBlockPublicAccessExclusion
+	resourceName := "aws_vpc_block_public_access_exclusion.test"
+	subnetResourceName := "aws_subnet.test.0"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCBlockPublicAccessExclusionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCBlockPublicAccessExclusionConfig_subnet(rName, "allow-egress"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCBlockPublicAccessExclusionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "internet_gateway_exclusion_mode", "allow-egress"),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_arn", subnetResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "subnet_id", subnetResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_id", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCBlockPublicAccessExclusion_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.VpcBlockPublicAccessExclusion
+	resourceName := "aws_vpc_block_public_access_exclusion.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCBlockPublicAccessExclusionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCBlockPublicAccessExclusionConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCBlockPublicAccessExclusionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVPCBlockPublicAccessExclusionConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCBlockPublicAccessExclusionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccVPCBlockPublicAccessExclusionConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCBlockPublicAccessExclusionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVPCBlockPublicAccessExclusionExists(ctx context.Context, n string, v *awstypes.VpcBlockPublicAccessExclusion) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No VPC Block Public Access Exclusion ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		output, err := tfec2.FindVPCBlockPublicAccessExclusionByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckVPCBlockPublicAccessExclusionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_vpc_block_public_access_exclusion" {
+				continue
+			}
+
+			_, err := tfec2.FindVPCBlockPublicAccessExclusionByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("VPC Block Public Access Exclusion %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccVPCBlockPublicAccessExclusionConfig_vpc(rName, mode string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
+resource "aws_vpc_block_public_access_exclusion" "test" {
+  vpc_id                          = aws_vpc.test.id
+  internet_gateway_exclusion_mode = %[1]q
+}
+`, mode))
+}
+
+func testAccVPCBlockPublicAccessExclusionConfig_subnet(rName, mode string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
+resource "aws_vpc_block_public_access_exclusion" "test" {
+  subnet_id                       = aws_subnet.test[0].id
+  internet_gateway_exclusion_mode = %[1]q
+}
+`, mode))
+}
+
+func testAccVPCBlockPublicAccessExclusionConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
+resource "aws_vpc_block_public_access_exclusion" "test" {
+  vpc_id                          = aws_vpc.test.id
+  internet_gateway_exclusion_mode = "allow-bidirectional"
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccVPCBlockPublicAccessExclusionConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
+resource "aws_vpc_block_public_access_exclusion" "test" {
+  vpc_id                          = aws_vpc.test.id
+  internet_gateway_exclusion_mode = "allow-bidirectional"
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,114 @@
+package ec2
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKResource("aws_vpc_block_public_access_options", name="VPC Block Public Access Options")
+func ResourceVPCBlockPublicAccessOptions() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceVPCBlockPublicAccessOptionsPut,
+		ReadWithoutTimeout:   resourceVPCBlockPublicAccessOptionsRead,
+		UpdateWithoutTimeout: resourceVPCBlockPublicAccessOptionsPut,
+		DeleteWithoutTimeout: resourceVPCBlockPublicAccessOptionsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"aws_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"aws_region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"internet_gateway_block_mode": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[types.InternetGatewayBlockMode](),
+			},
+		},
+	}
+}
+
+func resourceVPCBlockPublicAccessOptionsPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+
+	mode := types.InternetGatewayBlockMode(d.Get("internet_gateway_block_mode").(string))
+	if err := setVPCBlockPublicAccessOptions(ctx, conn, mode, timeout); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting VPC Block Public Access Options (%s): %s", mode, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(meta.(*conns.AWSClient).Region)
+	}
+
+	return append(diags, resourceVPCBlockPublicAccessOptionsRead(ctx, d, meta)...)
+}
+
+func resourceVPCBlockPublicAccessOptionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	output, err := FindVPCBlockPublicAccessOptions(ctx, conn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading VPC Block Public Access Options: %s", err)
+	}
+
+	d.Set("aws_account_id", output.AwsAccountId)
+	d.Set("aws_region", output.AwsRegion)
+	d.Set("internet_gateway_block_mode", output.InternetGatewayBlockMode)
+
+	return diags
+}
+
+func resourceVPCBlockPublicAccessOptionsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	// Removing the resource turns VPC Block Public Access off.
+	if err := setVPCBlockPublicAccessOptions(ctx, conn, types.InternetGatewayBlockModeOff, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "disabling VPC Block Public Access Options: %s", err)
+	}
+
+	return diags
+}
+
+func setVPCBlockPublicAccessOptions(ctx context.Context, conn *ec2.Client, mode types.InternetGatewayBlockMode, timeout time.Duration) error {
+	_, err := conn.ModifyVpcBlockPublicAccessOptions(ctx, &ec2.ModifyVpcBlockPublicAccessOptionsInput{
+		InternetGatewayBlockMode: mode,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	_, err = WaitVPCBlockPublicAccessOptionsUpdated(ctx, conn, timeout)
+
+	return err
+}
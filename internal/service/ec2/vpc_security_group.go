@@ -16,6 +16,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -63,7 +64,13 @@ func ResourceSecurityGroup() *schema.Resource {
 				Default:      "Managed by Terraform",
 				ValidateFunc: validation.StringLenBetween(0, 255),
 			},
-			"egress":  securityGroupRuleSetNestedBlock,
+			"egress": securityGroupRuleSetNestedBlock,
+			"exclusive_rules_management": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      securityGroupRulesManagementAuthoritative,
+				ValidateFunc: validation.StringInSlice(securityGroupRulesManagement_Values(), false),
+			},
 			"ingress": securityGroupRuleSetNestedBlock,
 			"name": {
 				Type:          schema.TypeString,
@@ -106,7 +113,10 @@ func ResourceSecurityGroup() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceSecurityGroupCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -277,10 +287,14 @@ func resourceSecurityGroupRead(ctx context.Context, d *schema.ResourceData, meta
 	localIngressRules := d.Get("ingress").(*schema.Set).List()
 	localEgressRules := d.Get("egress").(*schema.Set).List()
 
+	// In additive mode, rules not managed by this resource (e.g. those managed by
+	// aws_vpc_security_group_ingress_rule) are not read into state.
+	additive := d.Get("exclusive_rules_management").(string) == securityGroupRulesManagementAdditive
+
 	// Loop through the local state of rules, doing a match against the remote
 	// ruleSet we built above.
-	ingressRules := MatchRules("ingress", localIngressRules, remoteIngressRules)
-	egressRules := MatchRules("egress", localEgressRules, remoteEgressRules)
+	ingressRules := matchRules("ingress", localIngressRules, remoteIngressRules, !additive)
+	egressRules := matchRules("egress", localEgressRules, remoteEgressRules, !additive)
 
 	ownerID := aws.StringValue(sg.OwnerId)
 	arn := arn.ARN{
@@ -292,6 +306,9 @@ func resourceSecurityGroupRead(ctx context.Context, d *schema.ResourceData, meta
 	}
 	d.Set("arn", arn.String())
 	d.Set("description", sg.Description)
+	if _, ok := d.GetOk("exclusive_rules_management"); !ok {
+		d.Set("exclusive_rules_management", securityGroupRulesManagementAuthoritative)
+	}
 	d.Set("name", sg.GroupName)
 	d.Set("name_prefix", create.NamePrefixFromName(aws.StringValue(sg.GroupName)))
 	d.Set("owner_id", ownerID)
@@ -334,6 +351,26 @@ func resourceSecurityGroupUpdate(ctx context.Context, d *schema.ResourceData, me
 	return resourceSecurityGroupRead(ctx, d, meta)
 }
 
+func resourceSecurityGroupCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	// In authoritative mode state holds every rule on the group, including any managed elsewhere
+	// (e.g. by aws_vpc_security_group_ingress_rule). Once the mode is switched to additive there is
+	// no way to tell those apart from inline rules removed in the same apply, so require the switch
+	// to be made on its own.
+	if o, n := diff.GetChange("exclusive_rules_management"); o.(string) == securityGroupRulesManagementAuthoritative && n.(string) == securityGroupRulesManagementAdditive {
+		for _, ruleType := range []string{securityGroupRuleTypeEgress, securityGroupRuleTypeIngress} {
+			if diff.HasChange(ruleType) {
+				return fmt.Errorf("%s rules cannot be changed while exclusive_rules_management is changed from %q to %q; change the rules in a separate apply", ruleType, securityGroupRulesManagementAuthoritative, securityGroupRulesManagementAdditive)
+			}
+		}
+	}
+
+	return nil
+}
+
 func resourceSecurityGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
 
@@ -944,6 +981,12 @@ func FlattenSecurityGroups(list []*ec2.UserIdGroupPair, ownerId *string) []*Grou
 // If no match is found, we'll write the remote rule to state and let the graph
 // sort things out
 func MatchRules(rType string, local []interface{}, remote []map[string]interface{}) []map[string]interface{} {
+	return matchRules(rType, local, remote, true)
+}
+
+// matchRules is MatchRules with control over whether unmatched remote rules
+// are written to state.
+func matchRules(rType string, local []interface{}, remote []map[string]interface{}, saveUnmatched bool) []map[string]interface{} {
 	// For each local ip or security_group, we need to match against the remote
 	// ruleSet until all ips or security_groups are found
 
@@ -1220,6 +1263,10 @@ func MatchRules(rType string, local []interface{}, remote []map[string]interface
 			}
 		}
 	}
+	if !saveUnmatched {
+		return saves
+	}
+
 	// Here we catch any remote rules that have not been stripped of all self,
 	// cidrs, and security groups. We'll add remote rules here that have not been
 	// matched locally, and let the graph sort things out. This will happen when
@@ -0,0 +1,224 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @FrameworkResource(name="Security Group Rules Exclusive")
+func newResourceSecurityGroupRulesExclusive(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceSecurityGroupRulesExclusive{}, nil
+}
+
+type resourceSecurityGroupRulesExclusive struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *resourceSecurityGroupRulesExclusive) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_vpc_security_group_rules_exclusive"
+}
+
+func (r *resourceSecurityGroupRulesExclusive) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"egress_rule_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"id": framework.IDAttribute(),
+			"ingress_rule_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"security_group_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceSecurityGroupRulesExclusive) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceSecurityGroupRulesExclusiveData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.syncRules(ctx, &data); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating VPC Security Group (%s) exclusive rules", data.SecurityGroupID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ID = data.SecurityGroupID
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceSecurityGroupRulesExclusive) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceSecurityGroupRulesExclusiveData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Conn(ctx)
+
+	_, err := FindSecurityGroupByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		tflog.Warn(ctx, "VPC Security Group not found, removing exclusive rules from state", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading VPC Security Group (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	rules, err := FindSecurityGroupRulesBySecurityGroupID(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading VPC Security Group (%s) rules", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	egressRuleIDs, ingressRuleIDs := securityGroupRuleIDsByType(rules)
+
+	data.EgressRuleIDs = flex.FlattenFrameworkStringValueSetLegacy(ctx, egressRuleIDs)
+	data.IngressRuleIDs = flex.FlattenFrameworkStringValueSetLegacy(ctx, ingressRuleIDs)
+	data.SecurityGroupID = data.ID
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceSecurityGroupRulesExclusive) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resourceSecurityGroupRulesExclusiveData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !new.EgressRuleIDs.Equal(old.EgressRuleIDs) || !new.IngressRuleIDs.Equal(old.IngressRuleIDs) {
+		if err := r.syncRules(ctx, &new); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating VPC Security Group (%s) exclusive rules", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+// Delete removes the resource from state only. The security group's rules are left in place.
+func (r *resourceSecurityGroupRulesExclusive) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceSecurityGroupRulesExclusiveData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "removing VPC Security Group exclusive rules from state", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *resourceSecurityGroupRulesExclusive) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
+}
+
+// syncRules revokes any of the security group's rules whose IDs are not configured.
+func (r *resourceSecurityGroupRulesExclusive) syncRules(ctx context.Context, data *resourceSecurityGroupRulesExclusiveData) error {
+	conn := r.Meta().EC2Conn(ctx)
+	groupID := data.SecurityGroupID.ValueString()
+
+	rules, err := FindSecurityGroupRulesBySecurityGroupID(ctx, conn, groupID)
+
+	if err != nil {
+		return fmt.Errorf("reading rules: %w", err)
+	}
+
+	egressRuleIDs, ingressRuleIDs := securityGroupRuleIDsByType(rules)
+
+	if del := egressRuleIDs.Difference(flex.ExpandFrameworkStringValueSet(ctx, data.EgressRuleIDs)); len(del) > 0 {
+		_, err := conn.RevokeSecurityGroupEgressWithContext(ctx, &ec2.RevokeSecurityGroupEgressInput{
+			GroupId:              aws.String(groupID),
+			SecurityGroupRuleIds: aws.StringSlice(del),
+		})
+
+		if err != nil && !tfawserr.ErrCodeEquals(err, errCodeInvalidSecurityGroupRuleIdNotFound) {
+			return fmt.Errorf("revoking egress rules: %w", err)
+		}
+	}
+
+	if del := ingressRuleIDs.Difference(flex.ExpandFrameworkStringValueSet(ctx, data.IngressRuleIDs)); len(del) > 0 {
+		_, err := conn.RevokeSecurityGroupIngressWithContext(ctx, &ec2.RevokeSecurityGroupIngressInput{
+			GroupId:              aws.String(groupID),
+			SecurityGroupRuleIds: aws.StringSlice(del),
+		})
+
+		if err != nil && !tfawserr.ErrCodeEquals(err, errCodeInvalidSecurityGroupRuleIdNotFound) {
+			return fmt.Errorf("revoking ingress rules: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func securityGroupRuleIDsByType(rules []*ec2.SecurityGroupRule) (flex.Set[string], flex.Set[string]) {
+	var egressRuleIDs, ingressRuleIDs flex.Set[string]
+
+	for _, rule := range rules {
+		if aws.BoolValue(rule.IsEgress) {
+			egressRuleIDs = append(egressRuleIDs, aws.StringValue(rule.SecurityGroupRuleId))
+		} else {
+			ingressRuleIDs = append(ingressRuleIDs, aws.StringValue(rule.SecurityGroupRuleId))
+		}
+	}
+
+	return egressRuleIDs, ingressRuleIDs
+}
+
+type resourceSecurityGroupRulesExclusiveData struct {
+	EgressRuleIDs   types.Set    `tfsdk:"egress_rule_ids"`
+	ID              types.String `tfsdk:"id"`
+	IngressRuleIDs  types.Set    `tfsdk:"ingress_rule_ids"`
+	SecurityGroupID types.String `tfsdk:"security_group_id"`
+}
@@ -889,6 +889,7 @@ func TestAccVPCSecurityGroup_basic(t *testing.T) {
 					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ec2", regexp.MustCompile(`security-group/.+$`)),
 					resource.TestCheckResourceAttr(resourceName, "description", "Managed by Terraform"),
 					resource.TestCheckResourceAttr(resourceName, "egress.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "exclusive_rules_management", "authoritative"),
 					resource.TestCheckResourceAttr(resourceName, "ingress.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "name", rName),
 					resource.TestCheckResourceAttr(resourceName, "name_prefix", ""),
@@ -1760,6 +1761,103 @@ func TestAccVPCSecurityGroup_self(t *testing.T) {
 	})
 }
 
+func TestAccVPCSecurityGroup_exclusiveRulesManagementAdditive(t *testing.T) {
+	ctx := acctest.Context(t)
+	var group ec2.SecurityGroup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_security_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupConfig_exclusiveRulesManagement(rName, "additive"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupExists(ctx, resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "exclusive_rules_management", "additive"),
+					resource.TestCheckResourceAttr(resourceName, "ingress.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "ingress.*", map[string]string{
+						"protocol":  "tcp",
+						"from_port": "80",
+						"to_port":   "80",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "egress.#", "0"),
+				),
+			},
+			{
+				// The unmanaged ingress rule must not cause a diff.
+				Config:   testAccVPCSecurityGroupConfig_exclusiveRulesManagement(rName, "additive"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCSecurityGroup_exclusiveRulesManagementAuthoritativeToAdditive(t *testing.T) {
+	ctx := acctest.Context(t)
+	var group ec2.SecurityGroup
+	var rule ec2.SecurityGroupRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_security_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupConfig_exclusiveRulesManagementSwitch(rName, "authoritative", true, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupExists(ctx, resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "exclusive_rules_management", "authoritative"),
+					resource.TestCheckResourceAttr(resourceName, "ingress.#", "2"),
+				),
+			},
+			{
+				// Switching mode and removing an inline rule in the same apply is rejected.
+				Config:      testAccVPCSecurityGroupConfig_exclusiveRulesManagementSwitch(rName, "additive", false, false),
+				ExpectError: regexp.MustCompile(`ingress rules cannot be changed while exclusive_rules_management is changed`),
+			},
+			{
+				Config: testAccVPCSecurityGroupConfig_exclusiveRulesManagementSwitch(rName, "additive", true, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupExists(ctx, resourceName, &group),
+					testAccCheckSecurityGroupRuleCount(ctx, &group, 2, 0),
+					resource.TestCheckResourceAttr(resourceName, "exclusive_rules_management", "additive"),
+					resource.TestCheckResourceAttr(resourceName, "ingress.#", "2"),
+				),
+			},
+			{
+				// Once in additive mode, removing an inline rule revokes it.
+				Config: testAccVPCSecurityGroupConfig_exclusiveRulesManagementSwitch(rName, "additive", false, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupExists(ctx, resourceName, &group),
+					testAccCheckSecurityGroupRuleCount(ctx, &group, 1, 0),
+					resource.TestCheckResourceAttr(resourceName, "ingress.#", "1"),
+				),
+			},
+			{
+				// The separately managed ingress rule is neither revoked nor read into state.
+				Config: testAccVPCSecurityGroupConfig_exclusiveRulesManagementSwitch(rName, "additive", false, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupExists(ctx, resourceName, &group),
+					testAccCheckSecurityGroupRuleCount(ctx, &group, 2, 0),
+					testAccCheckSecurityGroupIngressRuleExists(ctx, "aws_vpc_security_group_ingress_rule.test", &rule),
+					resource.TestCheckResourceAttr(resourceName, "ingress.#", "1"),
+				),
+			},
+			{
+				Config:   testAccVPCSecurityGroupConfig_exclusiveRulesManagementSwitch(rName, "additive", false, true),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccVPCSecurityGroup_vpc(t *testing.T) {
 	ctx := acctest.Context(t)
 	var group ec2.SecurityGroup
@@ -2936,6 +3034,93 @@ resource "aws_security_group" "test" {
 `, rName)
 }
 
+func testAccVPCSecurityGroupConfig_exclusiveRulesManagement(rName, mode string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+
+  exclusive_rules_management = %[2]q
+
+  ingress {
+    protocol    = "tcp"
+    from_port   = 80
+    to_port     = 80
+    cidr_blocks = ["10.0.0.0/8"]
+  }
+}
+
+resource "aws_vpc_security_group_ingress_rule" "test" {
+  security_group_id = aws_security_group.test.id
+
+  cidr_ipv4   = "10.0.0.0/16"
+  from_port   = 443
+  ip_protocol = "tcp"
+  to_port     = 443
+}
+`, rName, mode)
+}
+
+func testAccVPCSecurityGroupConfig_exclusiveRulesManagementSwitch(rName, mode string, extraInlineRule, separateRule bool) string {
+	var extraInline, separate string
+
+	if extraInlineRule {
+		extraInline = `
+  ingress {
+    protocol    = "tcp"
+    from_port   = 8080
+    to_port     = 8080
+    cidr_blocks = ["10.0.0.0/8"]
+  }
+`
+	}
+
+	if separateRule {
+		separate = `
+resource "aws_vpc_security_group_ingress_rule" "test" {
+  security_group_id = aws_security_group.test.id
+
+  cidr_ipv4   = "10.0.0.0/16"
+  from_port   = 443
+  ip_protocol = "tcp"
+  to_port     = 443
+}
+`
+	}
+
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+
+  exclusive_rules_management = %[2]q
+
+  ingress {
+    protocol    = "tcp"
+    from_port   = 80
+    to_port     = 80
+    cidr_blocks = ["10.0.0.0/8"]
+  }
+%[3]s}
+%[4]s`, rName, mode, extraInline, separate)
+}
+
 func testAccVPCSecurityGroupConfig_nameGenerated(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_vpc" "test" {
@@ -118,6 +118,62 @@ func WaitCapacityReservationDeleted(ctx context.Context, conn *ec2.EC2, id strin
 	return nil, err
 }
 
+// WaitCapacityBlockReservationActive waits for a purchased Capacity Block's payment to be processed.
+// A Capacity Block is scheduled until its start date and active afterwards.
+func WaitCapacityBlockReservationActive(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.CapacityReservation, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{ec2.CapacityReservationStatePaymentPending},
+		Target:  []string{ec2.CapacityReservationStateActive, ec2.CapacityReservationStateScheduled},
+		Refresh: StatusCapacityReservationState(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.CapacityReservation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitCapacityReservationFleetActive(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.CapacityReservationFleet, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{ec2.CapacityReservationFleetStateSubmitted, ec2.CapacityReservationFleetStateModifying},
+		Target:  []string{ec2.CapacityReservationFleetStateActive, ec2.CapacityReservationFleetStatePartiallyFulfilled},
+		Refresh: StatusCapacityReservationFleetState(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.CapacityReservationFleet); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitCapacityReservationFleetDeleted(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.CapacityReservationFleet, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{ec2.CapacityReservationFleetStateActive, ec2.CapacityReservationFleetStatePartiallyFulfilled, ec2.CapacityReservationFleetStateCancelling},
+		Target:  []string{},
+		Refresh: StatusCapacityReservationFleetState(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.CapacityReservationFleet); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 const (
 	CarrierGatewayAvailableTimeout = 5 * time.Minute
 
@@ -2759,6 +2815,24 @@ func WaitEBSSnapshotImportComplete(ctx context.Context, conn *ec2.EC2, importTas
 	return nil, err
 }
 
+func WaitReplaceRootVolumeTaskSucceeded(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.ReplaceRootVolumeTask, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{ec2.ReplaceRootVolumeTaskStatePending, ec2.ReplaceRootVolumeTaskStateInProgress},
+		Target:  []string{ec2.ReplaceRootVolumeTaskStateSucceeded},
+		Refresh: StatusReplaceRootVolumeTaskState(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.ReplaceRootVolumeTask); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitVPCEndpointConnectionAccepted(ctx context.Context, conn *ec2.EC2, serviceID, vpcEndpointID string, timeout time.Duration) (*ec2.VpcEndpointConnection, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:    []string{vpcEndpointStatePendingAcceptance, vpcEndpointStatePending},
@@ -3240,3 +3314,167 @@ func WaitInstanceConnectEndpointDeleted(ctx context.Context, conn *ec2_sdkv2.Cli
 
 	return nil, err
 }
+
+func WaitVerifiedAccessEndpointCreated(ctx context.Context, conn *ec2_sdkv2.Client, id string, timeout time.Duration) (*types.VerifiedAccessEndpoint, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(types.VerifiedAccessEndpointStatusCodePending),
+		Target:                    enum.Slice(types.VerifiedAccessEndpointStatusCodeActive),
+		Refresh:                   StatusVerifiedAccessEndpoint(ctx, conn, id),
+		Timeout:                   timeout,
+		Delay:                     5 * time.Second,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.VerifiedAccessEndpoint); ok {
+		tfresource.SetLastError(err, errors.New(aws_sdkv2.ToString(output.Status.Message)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitVerifiedAccessEndpointUpdated(ctx context.Context, conn *ec2_sdkv2.Client, id string, timeout time.Duration) (*types.VerifiedAccessEndpoint, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(types.VerifiedAccessEndpointStatusCodeUpdating),
+		Target:                    enum.Slice(types.VerifiedAccessEndpointStatusCodeActive),
+		Refresh:                   StatusVerifiedAccessEndpoint(ctx, conn, id),
+		Timeout:                   timeout,
+		Delay:                     5 * time.Second,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.VerifiedAccessEndpoint); ok {
+		tfresource.SetLastError(err, errors.New(aws_sdkv2.ToString(output.Status.Message)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitVerifiedAccessEndpointDeleted(ctx context.Context, conn *ec2_sdkv2.Client, id string, timeout time.Duration) (*types.VerifiedAccessEndpoint, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.VerifiedAccessEndpointStatusCodeActive, types.VerifiedAccessEndpointStatusCodeDeleting),
+		Target:  []string{},
+		Refresh: StatusVerifiedAccessEndpoint(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.VerifiedAccessEndpoint); ok {
+		tfresource.SetLastError(err, errors.New(aws_sdkv2.ToString(output.Status.Message)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitImageBlockPublicAccessState(ctx context.Context, conn *ec2.EC2, target string, timeout time.Duration) error {
+	var pending []string
+	for _, v := range append(ec2.ImageBlockPublicAccessEnabledState_Values(), ec2.ImageBlockPublicAccessDisabledState_Values()...) {
+		if v != target {
+			pending = append(pending, v)
+		}
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending: pending,
+		Target:  []string{target},
+		Refresh: StatusImageBlockPublicAccessState(ctx, conn),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
+func WaitVPCBlockPublicAccessOptionsUpdated(ctx context.Context, conn *ec2_sdkv2.Client, timeout time.Duration) (*types.VpcBlockPublicAccessOptions, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.VpcBlockPublicAccessStateUpdateInProgress),
+		Target:  enum.Slice(types.VpcBlockPublicAccessStateUpdateComplete, types.VpcBlockPublicAccessStateDefaultState),
+		Refresh: StatusVPCBlockPublicAccessOptions(ctx, conn),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.VpcBlockPublicAccessOptions); ok {
+		tfresource.SetLastError(err, errors.New(aws_sdkv2.ToString(output.Reason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitVPCBlockPublicAccessExclusionCreated(ctx context.Context, conn *ec2_sdkv2.Client, id string, timeout time.Duration) (*types.VpcBlockPublicAccessExclusion, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.VpcBlockPublicAccessExclusionStateCreateInProgress),
+		Target:  enum.Slice(types.VpcBlockPublicAccessExclusionStateCreateComplete),
+		Refresh: StatusVPCBlockPublicAccessExclusion(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.VpcBlockPublicAccessExclusion); ok {
+		tfresource.SetLastError(err, errors.New(aws_sdkv2.ToString(output.Reason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitVPCBlockPublicAccessExclusionUpdated(ctx context.Context, conn *ec2_sdkv2.Client, id string, timeout time.Duration) (*types.VpcBlockPublicAccessExclusion, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.VpcBlockPublicAccessExclusionStateUpdateInProgress),
+		Target:  enum.Slice(types.VpcBlockPublicAccessExclusionStateUpdateComplete),
+		Refresh: StatusVPCBlockPublicAccessExclusion(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.VpcBlockPublicAccessExclusion); ok {
+		tfresource.SetLastError(err, errors.New(aws_sdkv2.ToString(output.Reason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitVPCBlockPublicAccessExclusionDeleted(ctx context.Context, conn *ec2_sdkv2.Client, id string, timeout time.Duration) (*types.VpcBlockPublicAccessExclusion, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.VpcBlockPublicAccessExclusionStateDeleteInProgress),
+		Target:  []string{},
+		Refresh: StatusVPCBlockPublicAccessExclusion(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.VpcBlockPublicAccessExclusion); ok {
+		tfresource.SetLastError(err, errors.New(aws_sdkv2.ToString(output.Reason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
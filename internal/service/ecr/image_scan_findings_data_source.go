@@ -0,0 +1,517 @@
+package ecr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// Severities from most to least severe. UNDEFINED findings never gate.
+var imageScanFindingSeverities = []string{
+	ecr.FindingSeverityCritical,
+	ecr.FindingSeverityHigh,
+	ecr.FindingSeverityMedium,
+	ecr.FindingSeverityLow,
+	ecr.FindingSeverityInformational,
+}
+
+// @SDKDataSource("aws_ecr_image_scan_findings")
+func DataSourceImageScanFindings() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceImageScanFindingsRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enhanced_findings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"finding_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"first_observed_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_observed_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"score": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"severity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"title": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vendor_severity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vulnerability_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vulnerable_packages": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"file_path": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"package_manager": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"version": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"fail_on_severity": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(imageScanFindingSeverities, false),
+			},
+			"finding_severity_counts": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"findings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attributes": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"severity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"uri": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"image_digest": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"image_digest", "image_tag"},
+			},
+			"image_scan_completed_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"image_tag": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"image_digest", "image_tag"},
+			},
+			"registry_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"repository_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"scan_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"scan_status_description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vulnerability_source_updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"wait_for_scan": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func dataSourceImageScanFindingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ECRConn(ctx)
+
+	repositoryName := d.Get("repository_name").(string)
+	input := &ecr.DescribeImageScanFindingsInput{
+		ImageId:        &ecr.ImageIdentifier{},
+		RepositoryName: aws.String(repositoryName),
+	}
+
+	if v, ok := d.GetOk("image_digest"); ok {
+		input.ImageId.ImageDigest = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("image_tag"); ok {
+		input.ImageId.ImageTag = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("registry_id"); ok {
+		input.RegistryId = aws.String(v.(string))
+	}
+
+	if d.Get("wait_for_scan").(bool) {
+		if _, err := waitImageScanComplete(ctx, conn, input, d.Timeout(schema.TimeoutRead)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for ECR Image Scan (%s) complete: %s", repositoryName, err)
+		}
+	}
+
+	output, err := FindImageScanFindings(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ECR Image Scan Findings (%s): %s", repositoryName, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s@%s", aws.StringValue(output.RepositoryName), aws.StringValue(output.ImageId.ImageDigest)))
+	d.Set("image_digest", output.ImageId.ImageDigest)
+	d.Set("registry_id", output.RegistryId)
+	d.Set("repository_name", output.RepositoryName)
+
+	if v := output.ImageScanStatus; v != nil {
+		d.Set("scan_status", v.Status)
+		d.Set("scan_status_description", v.Description)
+	} else {
+		d.Set("scan_status", nil)
+		d.Set("scan_status_description", nil)
+	}
+
+	counts := map[string]int64{}
+
+	if v := output.ImageScanFindings; v != nil {
+		if err := d.Set("enhanced_findings", flattenEnhancedImageScanFindings(v.EnhancedFindings)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting enhanced_findings: %s", err)
+		}
+		if err := d.Set("findings", flattenImageScanFindings(v.Findings)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting findings: %s", err)
+		}
+		if v.ImageScanCompletedAt != nil {
+			d.Set("image_scan_completed_at", aws.TimeValue(v.ImageScanCompletedAt).Format(time.RFC3339))
+		} else {
+			d.Set("image_scan_completed_at", nil)
+		}
+		if v.VulnerabilitySourceUpdatedAt != nil {
+			d.Set("vulnerability_source_updated_at", aws.TimeValue(v.VulnerabilitySourceUpdatedAt).Format(time.RFC3339))
+		} else {
+			d.Set("vulnerability_source_updated_at", nil)
+		}
+
+		counts = aws.Int64ValueMap(v.FindingSeverityCounts)
+	} else {
+		d.Set("enhanced_findings", nil)
+		d.Set("findings", nil)
+		d.Set("image_scan_completed_at", nil)
+		d.Set("vulnerability_source_updated_at", nil)
+	}
+
+	if err := d.Set("finding_severity_counts", counts); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting finding_severity_counts: %s", err)
+	}
+
+	if v, ok := d.GetOk("fail_on_severity"); ok {
+		if err := checkImageScanFindingSeverityCounts(counts, v.(string)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "ECR Image Scan Findings (%s): %s", d.Id(), err)
+		}
+	}
+
+	return diags
+}
+
+// checkImageScanFindingSeverityCounts returns an error if there are any findings at or above the specified severity.
+func checkImageScanFindingSeverityCounts(counts map[string]int64, threshold string) error {
+	var found []string
+
+	for _, severity := range imageScanFindingSeverities {
+		if n := counts[severity]; n > 0 {
+			found = append(found, fmt.Sprintf("%d %s", n, severity))
+		}
+
+		if severity == threshold {
+			break
+		}
+	}
+
+	if len(found) > 0 {
+		return fmt.Errorf("found findings at or above severity %s: %s", threshold, strings.Join(found, ", "))
+	}
+
+	return nil
+}
+
+// FindImageScanFindings returns the image scan findings from all pages merged into a single output.
+func FindImageScanFindings(ctx context.Context, conn *ecr.ECR, input *ecr.DescribeImageScanFindingsInput) (*ecr.DescribeImageScanFindingsOutput, error) {
+	var output *ecr.DescribeImageScanFindingsOutput
+
+	err := conn.DescribeImageScanFindingsPagesWithContext(ctx, input, func(page *ecr.DescribeImageScanFindingsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		if output == nil {
+			output = page
+			return !lastPage
+		}
+
+		if v := page.ImageScanFindings; v != nil {
+			if output.ImageScanFindings == nil {
+				output.ImageScanFindings = &ecr.ImageScanFindings{}
+			}
+			output.ImageScanFindings.EnhancedFindings = append(output.ImageScanFindings.EnhancedFindings, v.EnhancedFindings...)
+			output.ImageScanFindings.Findings = append(output.ImageScanFindings.Findings, v.Findings...)
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, ecr.ErrCodeImageNotFoundException, ecr.ErrCodeRepositoryNotFoundException, ecr.ErrCodeScanNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ImageId == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findImageScanStatus(ctx context.Context, conn *ecr.ECR, input *ecr.DescribeImageScanFindingsInput) (*ecr.ImageScanStatus, error) {
+	// Only the first page is needed for the status.
+	input = &ecr.DescribeImageScanFindingsInput{
+		ImageId:        input.ImageId,
+		MaxResults:     aws.Int64(1),
+		RegistryId:     input.RegistryId,
+		RepositoryName: input.RepositoryName,
+	}
+
+	output, err := conn.DescribeImageScanFindingsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ecr.ErrCodeImageNotFoundException, ecr.ErrCodeRepositoryNotFoundException, ecr.ErrCodeScanNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ImageScanStatus == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ImageScanStatus, nil
+}
+
+func statusImageScan(ctx context.Context, conn *ecr.ECR, input *ecr.DescribeImageScanFindingsInput) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findImageScanStatus(ctx, conn, input)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitImageScanComplete(ctx context.Context, conn *ecr.ECR, input *ecr.DescribeImageScanFindingsInput, timeout time.Duration) (*ecr.ImageScanStatus, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{ecr.ScanStatusInProgress, ecr.ScanStatusPending},
+		// Enhanced (continuous) scanning reports ACTIVE once findings are available.
+		Target:  []string{ecr.ScanStatusActive, ecr.ScanStatusComplete},
+		Refresh: statusImageScan(ctx, conn, input),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ecr.ImageScanStatus); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.Description)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func flattenImageScanFindings(apiObjects []*ecr.ImageScanFinding) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		attributes := map[string]interface{}{}
+		for _, v := range apiObject.Attributes {
+			if v == nil {
+				continue
+			}
+
+			attributes[aws.StringValue(v.Key)] = aws.StringValue(v.Value)
+		}
+
+		tfMap := map[string]interface{}{
+			"attributes":  attributes,
+			"description": aws.StringValue(apiObject.Description),
+			"name":        aws.StringValue(apiObject.Name),
+			"severity":    aws.StringValue(apiObject.Severity),
+			"uri":         aws.StringValue(apiObject.Uri),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenEnhancedImageScanFindings(apiObjects []*ecr.EnhancedImageScanFinding) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"description": aws.StringValue(apiObject.Description),
+			"finding_arn": aws.StringValue(apiObject.FindingArn),
+			"score":       aws.Float64Value(apiObject.Score),
+			"severity":    aws.StringValue(apiObject.Severity),
+			"status":      aws.StringValue(apiObject.Status),
+			"title":       aws.StringValue(apiObject.Title),
+			"type":        aws.StringValue(apiObject.Type),
+		}
+
+		if v := apiObject.FirstObservedAt; v != nil {
+			tfMap["first_observed_at"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.LastObservedAt; v != nil {
+			tfMap["last_observed_at"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.PackageVulnerabilityDetails; v != nil {
+			tfMap["source"] = aws.StringValue(v.Source)
+			tfMap["source_url"] = aws.StringValue(v.SourceUrl)
+			tfMap["vendor_severity"] = aws.StringValue(v.VendorSeverity)
+			tfMap["vulnerability_id"] = aws.StringValue(v.VulnerabilityId)
+			tfMap["vulnerable_packages"] = flattenVulnerablePackages(v.VulnerablePackages)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenVulnerablePackages(apiObjects []*ecr.VulnerablePackage) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"file_path":       aws.StringValue(apiObject.FilePath),
+			"name":            aws.StringValue(apiObject.Name),
+			"package_manager": aws.StringValue(apiObject.PackageManager),
+			"version":         aws.StringValue(apiObject.Version),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,85 @@
+package ecr_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccECRImageScanFindingsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	testAccImageScanFindingsPreCheck(t)
+	repositoryName := os.Getenv("AWS_ECR_IMAGE_SCAN_REPOSITORY_NAME")
+	imageTag := os.Getenv("AWS_ECR_IMAGE_SCAN_IMAGE_TAG")
+	dataSourceName := "data.aws_ecr_image_scan_findings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecr.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImageScanFindingsDataSourceConfig_basic(repositoryName, imageTag),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "finding_severity_counts.%"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "image_digest"),
+					acctest.CheckResourceAttrAccountID(dataSourceName, "registry_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "repository_name", repositoryName),
+					resource.TestMatchResourceAttr(dataSourceName, "scan_status", regexp.MustCompile(`^(ACTIVE|COMPLETE)$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECRImageScanFindingsDataSource_failOnSeverity(t *testing.T) {
+	ctx := acctest.Context(t)
+	testAccImageScanFindingsPreCheck(t)
+	repositoryName := os.Getenv("AWS_ECR_IMAGE_SCAN_REPOSITORY_NAME")
+	imageTag := os.Getenv("AWS_ECR_IMAGE_SCAN_IMAGE_TAG")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecr.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Any scanned image is expected to have at least one informational or more severe finding.
+				Config:      testAccImageScanFindingsDataSourceConfig_failOnSeverity(repositoryName, imageTag, "INFORMATIONAL"),
+				ExpectError: regexp.MustCompile(`found findings at or above severity INFORMATIONAL`),
+			},
+		},
+	})
+}
+
+// The tests cannot push images, so an already pushed and scanned image is required.
+func testAccImageScanFindingsPreCheck(t *testing.T) {
+	if os.Getenv("AWS_ECR_IMAGE_SCAN_REPOSITORY_NAME") == "" || os.Getenv("AWS_ECR_IMAGE_SCAN_IMAGE_TAG") == "" {
+		t.Skip("AWS_ECR_IMAGE_SCAN_REPOSITORY_NAME and AWS_ECR_IMAGE_SCAN_IMAGE_TAG env vars must be set for ECR Image Scan Findings Data Source acceptance tests.")
+	}
+}
+
+func testAccImageScanFindingsDataSourceConfig_basic(repositoryName, imageTag string) string {
+	return fmt.Sprintf(`
+data "aws_ecr_image_scan_findings" "test" {
+  repository_name = %[1]q
+  image_tag       = %[2]q
+  wait_for_scan   = true
+}
+`, repositoryName, imageTag)
+}
+
+func testAccImageScanFindingsDataSourceConfig_failOnSeverity(repositoryName, imageTag, severity string) string {
+	return fmt.Sprintf(`
+data "aws_ecr_image_scan_findings" "test" {
+  repository_name  = %[1]q
+  image_tag        = %[2]q
+  fail_on_severity = %[3]q
+}
+`, repositoryName, imageTag, severity)
+}
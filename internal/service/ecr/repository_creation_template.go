@@ -0,0 +1,335 @@
+package ecr
+
+import (
+	"context"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_ecr_repository_creation_template", name="Repository Creation Template")
+func ResourceRepositoryCreationTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRepositoryCreationTemplateCreate,
+		ReadWithoutTimeout:   resourceRepositoryCreationTemplateRead,
+		UpdateWithoutTimeout: resourceRepositoryCreationTemplateUpdate,
+		DeleteWithoutTimeout: resourceRepositoryCreationTemplateDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"applied_for": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(ecr.RCTAppliedFor_Values(), false),
+				},
+			},
+			"custom_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"encryption_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"encryption_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      ecr.EncryptionTypeAes256,
+							ValidateFunc: validation.StringInSlice(ecr.EncryptionType_Values(), false),
+						},
+						"kms_key": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+				DiffSuppressFunc: verify.SuppressMissingOptionalConfigurationBlock,
+			},
+			"image_tag_mutability": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      ecr.ImageTagMutabilityMutable,
+				ValidateFunc: validation.StringInSlice(ecr.ImageTagMutability_Values(), false),
+			},
+			"lifecycle_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					equal, _ := equivalentLifecyclePolicyJSON(old, new)
+
+					return equal
+				},
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"prefix": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 256),
+					validation.StringMatch(
+						regexp.MustCompile(`^((?:[a-z0-9]+(?:[._-][a-z0-9]+)*/)*[a-z0-9]+(?:[._-][a-z0-9]+)*/?|ROOT)$`),
+						"must be ROOT or only include lowercase alphanumeric, underscore, period, hyphen or slash characters"),
+				),
+			},
+			"registry_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"repository_policy": {
+				Type:                  schema.TypeString,
+				Optional:              true,
+				ValidateFunc:          validation.StringIsJSON,
+				DiffSuppressFunc:      verify.SuppressEquivalentPolicyDiffs,
+				DiffSuppressOnRefresh: true,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"resource_tags": tftags.TagsSchema(),
+		},
+	}
+}
+
+func resourceRepositoryCreationTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ECRConn(ctx)
+
+	prefix := d.Get("prefix").(string)
+	input := &ecr.CreateRepositoryCreationTemplateInput{
+		AppliedFor:         flex.ExpandStringSet(d.Get("applied_for").(*schema.Set)),
+		ImageTagMutability: aws.String(d.Get("image_tag_mutability").(string)),
+		Prefix:             aws.String(prefix),
+	}
+
+	if v, ok := d.GetOk("custom_role_arn"); ok {
+		input.CustomRoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("encryption_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.EncryptionConfiguration = expandRepositoryCreationTemplateEncryptionConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("lifecycle_policy"); ok {
+		policy, err := structure.NormalizeJsonString(v.(string))
+
+		if err != nil {
+			return diag.Errorf("lifecycle_policy (%s) is invalid JSON: %s", v.(string), err)
+		}
+
+		input.LifecyclePolicy = aws.String(policy)
+	}
+
+	if v, ok := d.GetOk("repository_policy"); ok {
+		policy, err := structure.NormalizeJsonString(v.(string))
+
+		if err != nil {
+			return diag.Errorf("repository_policy (%s) is invalid JSON: %s", v.(string), err)
+		}
+
+		input.RepositoryPolicy = aws.String(policy)
+	}
+
+	if v, ok := d.GetOk("resource_tags"); ok && len(v.(map[string]interface{})) > 0 {
+		input.ResourceTags = Tags(tftags.New(ctx, v.(map[string]interface{})))
+	}
+
+	_, err := conn.CreateRepositoryCreationTemplateWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating ECR Repository Creation Template (%s): %s", prefix, err)
+	}
+
+	d.SetId(prefix)
+
+	return resourceRepositoryCreationTemplateRead(ctx, d, meta)
+}
+
+func resourceRepositoryCreationTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ECRConn(ctx)
+
+	registryID, template, err := FindRepositoryCreationTemplateByRepositoryPrefix(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] ECR Repository Creation Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading ECR Repository Creation Template (%s): %s", d.Id(), err)
+	}
+
+	d.Set("applied_for", aws.StringValueSlice(template.AppliedFor))
+	d.Set("custom_role_arn", template.CustomRoleArn)
+	d.Set("description", template.Description)
+	if err := d.Set("encryption_configuration", flattenRepositoryCreationTemplateEncryptionConfiguration(template.EncryptionConfiguration)); err != nil {
+		return diag.Errorf("setting encryption_configuration: %s", err)
+	}
+	d.Set("image_tag_mutability", template.ImageTagMutability)
+
+	if v := aws.StringValue(template.LifecyclePolicy); v != "" {
+		policyToSet, err := structure.NormalizeJsonString(v)
+
+		if err != nil {
+			return diag.Errorf("reading ECR Repository Creation Template (%s): setting lifecycle_policy: %s", d.Id(), err)
+		}
+
+		d.Set("lifecycle_policy", policyToSet)
+	} else {
+		d.Set("lifecycle_policy", nil)
+	}
+
+	d.Set("prefix", template.Prefix)
+	d.Set("registry_id", registryID)
+
+	if v := aws.StringValue(template.RepositoryPolicy); v != "" {
+		policyToSet, err := verify.PolicyToSet(d.Get("repository_policy").(string), v)
+
+		if err != nil {
+			return diag.Errorf("reading ECR Repository Creation Template (%s): setting repository_policy: %s", d.Id(), err)
+		}
+
+		d.Set("repository_policy", policyToSet)
+	} else {
+		d.Set("repository_policy", nil)
+	}
+
+	if err := d.Set("resource_tags", KeyValueTags(ctx, template.ResourceTags).Map()); err != nil {
+		return diag.Errorf("setting resource_tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceRepositoryCreationTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ECRConn(ctx)
+
+	// Unset optional fields are cleared by sending their zero values.
+	input := &ecr.UpdateRepositoryCreationTemplateInput{
+		AppliedFor:         flex.ExpandStringSet(d.Get("applied_for").(*schema.Set)),
+		CustomRoleArn:      aws.String(d.Get("custom_role_arn").(string)),
+		Description:        aws.String(d.Get("description").(string)),
+		ImageTagMutability: aws.String(d.Get("image_tag_mutability").(string)),
+		Prefix:             aws.String(d.Id()),
+		ResourceTags:       Tags(tftags.New(ctx, d.Get("resource_tags").(map[string]interface{}))),
+	}
+
+	if v, ok := d.GetOk("encryption_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.EncryptionConfiguration = expandRepositoryCreationTemplateEncryptionConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v := d.Get("lifecycle_policy").(string); v != "" {
+		policy, err := structure.NormalizeJsonString(v)
+
+		if err != nil {
+			return diag.Errorf("lifecycle_policy (%s) is invalid JSON: %s", v, err)
+		}
+
+		input.LifecyclePolicy = aws.String(policy)
+	} else {
+		input.LifecyclePolicy = aws.String("")
+	}
+
+	if v := d.Get("repository_policy").(string); v != "" {
+		policy, err := structure.NormalizeJsonString(v)
+
+		if err != nil {
+			return diag.Errorf("repository_policy (%s) is invalid JSON: %s", v, err)
+		}
+
+		input.RepositoryPolicy = aws.String(policy)
+	} else {
+		input.RepositoryPolicy = aws.String("")
+	}
+
+	_, err := conn.UpdateRepositoryCreationTemplateWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("updating ECR Repository Creation Template (%s): %s", d.Id(), err)
+	}
+
+	return resourceRepositoryCreationTemplateRead(ctx, d, meta)
+}
+
+func resourceRepositoryCreationTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ECRConn(ctx)
+
+	log.Printf("[DEBUG] Deleting ECR Repository Creation Template: %s", d.Id())
+	_, err := conn.DeleteRepositoryCreationTemplateWithContext(ctx, &ecr.DeleteRepositoryCreationTemplateInput{
+		Prefix: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ecr.ErrCodeTemplateNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting ECR Repository Creation Template (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandRepositoryCreationTemplateEncryptionConfiguration(tfMap map[string]interface{}) *ecr.EncryptionConfigurationForRepositoryCreationTemplate {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ecr.EncryptionConfigurationForRepositoryCreationTemplate{
+		EncryptionType: aws.String(tfMap["encryption_type"].(string)),
+	}
+
+	if v, ok := tfMap["kms_key"].(string); ok && v != "" {
+		apiObject.KmsKey = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenRepositoryCreationTemplateEncryptionConfiguration(apiObject *ecr.EncryptionConfigurationForRepositoryCreationTemplate) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"encryption_type": aws.StringValue(apiObject.EncryptionType),
+		"kms_key":         aws.StringValue(apiObject.KmsKey),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -33,6 +33,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceImage,
 			TypeName: "aws_ecr_image",
 		},
+		{
+			Factory:  DataSourceImageScanFindings,
+			TypeName: "aws_ecr_image_scan_findings",
+		},
 		{
 			Factory:  DataSourcePullThroughCacheRule,
 			TypeName: "aws_ecr_pull_through_cache_rule",
@@ -74,6 +78,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory:  ResourceRepositoryCreationTemplate,
+			TypeName: "aws_ecr_repository_creation_template",
+			Name:     "Repository Creation Template",
+		},
 		{
 			Factory:  ResourceRepositoryPolicy,
 			TypeName: "aws_ecr_repository_policy",
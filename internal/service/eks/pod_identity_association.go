@@ -0,0 +1,293 @@
+package eks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_eks_pod_identity_association", name="Pod Identity Association")
+// @Tags(identifierAttribute="association_arn")
+func ResourcePodIdentityAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePodIdentityAssociationCreate,
+		ReadWithoutTimeout:   resourcePodIdentityAssociationRead,
+		UpdateWithoutTimeout: resourcePodIdentityAssociationUpdate,
+		DeleteWithoutTimeout: resourcePodIdentityAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"association_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"association_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cluster_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validClusterName,
+			},
+			"namespace": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"service_account": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourcePodIdentityAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EKSClient(ctx)
+
+	clusterName := d.Get("cluster_name").(string)
+	roleARN := d.Get("role_arn").(string)
+
+	if err := checkPodIdentityAssociationRoleTrustPolicy(ctx, meta.(*conns.AWSClient).IAMConn(ctx), roleARN); err != nil {
+		return diag.Errorf("creating EKS Pod Identity Association (%s): %s", clusterName, err)
+	}
+
+	input := &eks.CreatePodIdentityAssociationInput{
+		ClientRequestToken: aws.String(id.UniqueId()),
+		ClusterName:        aws.String(clusterName),
+		Namespace:          aws.String(d.Get("namespace").(string)),
+		RoleArn:            aws.String(roleARN),
+		ServiceAccount:     aws.String(d.Get("service_account").(string)),
+		Tags:               aws.StringValueMap(getTagsIn(ctx)),
+	}
+
+	output, err := conn.CreatePodIdentityAssociation(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating EKS Pod Identity Association (%s): %s", clusterName, err)
+	}
+
+	d.SetId(PodIdentityAssociationCreateResourceID(clusterName, aws.StringValue(output.Association.AssociationId)))
+
+	return resourcePodIdentityAssociationRead(ctx, d, meta)
+}
+
+func resourcePodIdentityAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EKSClient(ctx)
+
+	clusterName, associationID, err := PodIdentityAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	association, err := FindPodIdentityAssociationByClusterNameAndAssociationID(ctx, conn, clusterName, associationID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EKS Pod Identity Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading EKS Pod Identity Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("association_arn", association.AssociationArn)
+	d.Set("association_id", association.AssociationId)
+	d.Set("cluster_name", association.ClusterName)
+	d.Set("namespace", association.Namespace)
+	d.Set("role_arn", association.RoleArn)
+	d.Set("service_account", association.ServiceAccount)
+
+	setTagsOut(ctx, aws.StringMap(association.Tags))
+
+	return nil
+}
+
+func resourcePodIdentityAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EKSClient(ctx)
+
+	if d.HasChange("role_arn") {
+		clusterName, associationID, err := PodIdentityAssociationParseResourceID(d.Id())
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		roleARN := d.Get("role_arn").(string)
+
+		if err := checkPodIdentityAssociationRoleTrustPolicy(ctx, meta.(*conns.AWSClient).IAMConn(ctx), roleARN); err != nil {
+			return diag.Errorf("updating EKS Pod Identity Association (%s): %s", d.Id(), err)
+		}
+
+		input := &eks.UpdatePodIdentityAssociationInput{
+			AssociationId:      aws.String(associationID),
+			ClientRequestToken: aws.String(id.UniqueId()),
+			ClusterName:        aws.String(clusterName),
+			RoleArn:            aws.String(roleARN),
+		}
+
+		_, err = conn.UpdatePodIdentityAssociation(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating EKS Pod Identity Association (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourcePodIdentityAssociationRead(ctx, d, meta)
+}
+
+func resourcePodIdentityAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EKSClient(ctx)
+
+	clusterName, associationID, err := PodIdentityAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting EKS Pod Identity Association: %s", d.Id())
+	_, err = conn.DeletePodIdentityAssociation(ctx, &eks.DeletePodIdentityAssociationInput{
+		AssociationId: aws.String(associationID),
+		ClusterName:   aws.String(clusterName),
+	})
+
+	var nfe *types.ResourceNotFoundException
+	if errors.As(err, &nfe) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting EKS Pod Identity Association (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// checkPodIdentityAssociationRoleTrustPolicy verifies that the trust policy of the specified IAM role
+// allows it to be assumed by EKS Pod Identity.
+// The check is skipped if the caller isn't permitted to read the role.
+func checkPodIdentityAssociationRoleTrustPolicy(ctx context.Context, conn *iam.IAM, roleARN string) error {
+	parsedARN, err := arn.Parse(roleARN)
+
+	if err != nil {
+		return fmt.Errorf("parsing IAM Role ARN (%s): %w", roleARN, err)
+	}
+
+	// Role ARN resources are of the form "role/path/name".
+	roleName := parsedARN.Resource[strings.LastIndex(parsedARN.Resource, "/")+1:]
+
+	role, err := tfiam.FindRoleByName(ctx, conn, roleName)
+
+	if tfawserr.ErrCodeEquals(err, "AccessDenied") {
+		log.Printf("[WARN] Unable to read IAM Role (%s), skipping trust policy check: %s", roleARN, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading IAM Role (%s): %w", roleARN, err)
+	}
+
+	policy, err := url.QueryUnescape(aws.StringValue(role.AssumeRolePolicyDocument))
+
+	if err != nil {
+		return fmt.Errorf("decoding IAM Role (%s) trust policy: %w", roleARN, err)
+	}
+
+	if err := validPodIdentityRoleTrustPolicy(policy); err != nil {
+		return fmt.Errorf("IAM Role (%s): %w", roleARN, err)
+	}
+
+	return nil
+}
+
+func FindPodIdentityAssociationByClusterNameAndAssociationID(ctx context.Context, conn *eks.Client, clusterName, associationID string) (*types.PodIdentityAssociation, error) {
+	input := &eks.DescribePodIdentityAssociationInput{
+		AssociationId: aws.String(associationID),
+		ClusterName:   aws.String(clusterName),
+	}
+
+	output, err := conn.DescribePodIdentityAssociation(ctx, input)
+
+	var nfe *types.ResourceNotFoundException
+	if errors.As(err, &nfe) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Association == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Association, nil
+}
+
+func findPodIdentityAssociationSummaries(ctx context.Context, conn *eks.Client, input *eks.ListPodIdentityAssociationsInput) ([]types.PodIdentityAssociationSummary, error) {
+	var output []types.PodIdentityAssociationSummary
+
+	pages := eks.NewListPodIdentityAssociationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		var nfe *types.ResourceNotFoundException
+		if errors.As(err, &nfe) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Associations...)
+	}
+
+	return output, nil
+}
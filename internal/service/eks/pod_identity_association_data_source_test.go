@@ -0,0 +1,81 @@
+package eks_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/eks"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccEKSPodIdentityAssociationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_eks_pod_identity_association.test"
+	resourceName := "aws_eks_pod_identity_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, eks.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPodIdentityAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPodIdentityAssociationDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "association_arn", dataSourceName, "association_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "association_id", dataSourceName, "association_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_name", dataSourceName, "cluster_name"),
+					resource.TestCheckResourceAttrPair(resourceName, "namespace", dataSourceName, "namespace"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", dataSourceName, "role_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "service_account", dataSourceName, "service_account"),
+					resource.TestCheckResourceAttrPair(resourceName, "tags.%", dataSourceName, "tags.%"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEKSPodIdentityAssociationDataSource_serviceAccount(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_eks_pod_identity_association.test"
+	resourceName := "aws_eks_pod_identity_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, eks.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPodIdentityAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPodIdentityAssociationDataSourceConfig_serviceAccount(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "association_arn", dataSourceName, "association_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "association_id", dataSourceName, "association_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", dataSourceName, "role_arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPodIdentityAssociationDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccPodIdentityAssociationConfig_tags1(rName, "key1", "value1"), `
+data "aws_eks_pod_identity_association" "test" {
+  cluster_name   = aws_eks_pod_identity_association.test.cluster_name
+  association_id = aws_eks_pod_identity_association.test.association_id
+}
+`)
+}
+
+func testAccPodIdentityAssociationDataSourceConfig_serviceAccount(rName string) string {
+	return acctest.ConfigCompose(testAccPodIdentityAssociationConfig_basic(rName), `
+data "aws_eks_pod_identity_association" "test" {
+  cluster_name    = aws_eks_pod_identity_association.test.cluster_name
+  namespace       = aws_eks_pod_identity_association.test.namespace
+  service_account = aws_eks_pod_identity_association.test.service_account
+}
+`)
+}
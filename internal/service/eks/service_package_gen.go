@@ -5,6 +5,8 @@ package eks
 import (
 	"context"
 
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	eks_sdkv2 "github.com/aws/aws-sdk-go-v2/service/eks"
 	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
 	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
 	eks_sdkv1 "github.com/aws/aws-sdk-go/service/eks"
@@ -53,6 +55,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceNodeGroups,
 			TypeName: "aws_eks_node_groups",
 		},
+		{
+			Factory:  DataSourcePodIdentityAssociation,
+			TypeName: "aws_eks_pod_identity_association",
+		},
 	}
 }
 
@@ -98,6 +104,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory:  ResourcePodIdentityAssociation,
+			TypeName: "aws_eks_pod_identity_association",
+			Name:     "Pod Identity Association",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "association_arn",
+			},
+		},
 	}
 }
 
@@ -112,6 +126,17 @@ func (p *servicePackage) NewConn(ctx context.Context, config map[string]any) (*e
 	return eks_sdkv1.New(sess.Copy(&aws_sdkv1.Config{Endpoint: aws_sdkv1.String(config["endpoint"].(string))})), nil
 }
 
+// NewClient returns a new AWS SDK for Go v2 client for this service package's AWS API.
+func (p *servicePackage) NewClient(ctx context.Context, config map[string]any) (*eks_sdkv2.Client, error) {
+	cfg := *(config["aws_sdkv2_config"].(*aws_sdkv2.Config))
+
+	return eks_sdkv2.NewFromConfig(cfg, func(o *eks_sdkv2.Options) {
+		if endpoint := config["endpoint"].(string); endpoint != "" {
+			o.EndpointResolver = eks_sdkv2.EndpointResolverFromURL(endpoint)
+		}
+	}), nil
+}
+
 func ServicePackage(ctx context.Context) conns.ServicePackage {
 	return &servicePackage{}
 }
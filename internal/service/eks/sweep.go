@@ -7,12 +7,14 @@ import (
 	"fmt"
 	"log"
 
+	eksv2 "github.com/aws/aws-sdk-go-v2/service/eks"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func init() {
@@ -28,6 +30,7 @@ func init() {
 			"aws_eks_addon",
 			"aws_eks_fargate_profile",
 			"aws_eks_node_group",
+			"aws_eks_pod_identity_association",
 			"aws_emrcontainers_virtual_cluster",
 		},
 	})
@@ -46,6 +49,11 @@ func init() {
 		Name: "aws_eks_node_group",
 		F:    sweepNodeGroups,
 	})
+
+	resource.AddTestSweepers("aws_eks_pod_identity_association", &resource.Sweeper{
+		Name: "aws_eks_pod_identity_association",
+		F:    sweepPodIdentityAssociations,
+	})
 }
 
 func sweepAddons(region string) error {
@@ -386,3 +394,66 @@ func sweepNodeGroups(region string) error {
 
 	return sweeperErrs.ErrorOrNil()
 }
+
+func sweepPodIdentityAssociations(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.EKSConn(ctx)
+	input := &eks.ListClustersInput{}
+	var sweeperErrs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListClustersPagesWithContext(ctx, input, func(page *eks.ListClustersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, cluster := range page.Clusters {
+			input := &eksv2.ListPodIdentityAssociationsInput{
+				ClusterName: cluster,
+			}
+
+			associations, err := findPodIdentityAssociationSummaries(ctx, client.EKSClient(ctx), input)
+
+			if sweep.SkipSweepError(err) || tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing EKS Pod Identity Associations (%s): %w", region, err))
+				continue
+			}
+
+			for _, association := range associations {
+				r := ResourcePodIdentityAssociation()
+				d := r.Data(nil)
+				d.SetId(PodIdentityAssociationCreateResourceID(aws.StringValue(cluster), aws.StringValue(association.AssociationId)))
+
+				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Print(fmt.Errorf("[WARN] Skipping EKS Pod Identity Associations sweep for %s: %w", region, err))
+		return sweeperErrs // In case we have completed some pages, but had errors
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing EKS Clusters (%s): %w", region, err))
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping EKS Pod Identity Associations (%s): %w", region, err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
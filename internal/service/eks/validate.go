@@ -1,8 +1,10 @@
 package eks
 
 import (
+	"encoding/json"
 	"fmt"
 	"regexp"
+	"strings"
 )
 
 func validClusterName(v interface{}, k string) (ws []string, errors []error) {
@@ -22,3 +24,89 @@ func validClusterName(v interface{}, k string) (ws []string, errors []error) {
 
 	return
 }
+
+const (
+	podIdentityServicePrincipal = "pods.eks.amazonaws.com"
+)
+
+// validPodIdentityRoleTrustPolicy returns an error if the specified IAM role trust policy
+// does not allow EKS Pod Identity to assume the role and tag the session.
+// See https://docs.aws.amazon.com/eks/latest/userguide/pod-id-role.html.
+func validPodIdentityRoleTrustPolicy(policy string) error {
+	var doc struct {
+		Statement interface{}
+	}
+
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+		return fmt.Errorf("parsing trust policy: %w", err)
+	}
+
+	var statements []interface{}
+	switch v := doc.Statement.(type) {
+	case []interface{}:
+		statements = v
+	case map[string]interface{}:
+		statements = []interface{}{v}
+	}
+
+	requiredActions := []string{"sts:AssumeRole", "sts:TagSession"}
+	allowedActions := make(map[string]bool)
+
+	for _, v := range statements {
+		statement, ok := v.(map[string]interface{})
+
+		if !ok || statement["Effect"] != "Allow" || !trustPolicyStatementHasServicePrincipal(statement, podIdentityServicePrincipal) {
+			continue
+		}
+
+		for _, action := range trustPolicyStringOrSlice(statement["Action"]) {
+			action = strings.ToLower(action)
+
+			for _, requiredAction := range requiredActions {
+				if action == "*" || action == "sts:*" || action == strings.ToLower(requiredAction) {
+					allowedActions[requiredAction] = true
+				}
+			}
+		}
+	}
+
+	for _, requiredAction := range requiredActions {
+		if !allowedActions[requiredAction] {
+			return fmt.Errorf("trust policy does not allow %q to perform %q", podIdentityServicePrincipal, requiredAction)
+		}
+	}
+
+	return nil
+}
+
+func trustPolicyStatementHasServicePrincipal(statement map[string]interface{}, servicePrincipal string) bool {
+	switch v := statement["Principal"].(type) {
+	case string:
+		return v == "*"
+	case map[string]interface{}:
+		for _, principal := range trustPolicyStringOrSlice(v["Service"]) {
+			if principal == servicePrincipal {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func trustPolicyStringOrSlice(v interface{}) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		var s []string
+		for _, v := range v {
+			if v, ok := v.(string); ok {
+				s = append(s, v)
+			}
+		}
+		return s
+	}
+
+	return nil
+}
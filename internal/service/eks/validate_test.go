@@ -59,3 +59,110 @@ func TestValidClusterName(t *testing.T) {
 		}
 	}
 }
+
+func TestValidPodIdentityRoleTrustPolicy(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name        string
+		Policy      string
+		ExpectError bool
+	}{
+		{
+			Name: "valid",
+			Policy: `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {"Service": "pods.eks.amazonaws.com"},
+    "Action": ["sts:AssumeRole", "sts:TagSession"]
+  }]
+}`,
+		},
+		{
+			Name: "single statement, wildcard action",
+			Policy: `{
+  "Version": "2012-10-17",
+  "Statement": {
+    "Effect": "Allow",
+    "Principal": {"Service": ["ec2.amazonaws.com", "pods.eks.amazonaws.com"]},
+    "Action": "sts:*"
+  }
+}`,
+		},
+		{
+			Name: "actions split across statements",
+			Policy: `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {"Service": "pods.eks.amazonaws.com"},
+    "Action": "sts:AssumeRole"
+  }, {
+    "Effect": "Allow",
+    "Principal": {"Service": "pods.eks.amazonaws.com"},
+    "Action": "sts:TagSession"
+  }]
+}`,
+		},
+		{
+			Name: "missing TagSession",
+			Policy: `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {"Service": "pods.eks.amazonaws.com"},
+    "Action": "sts:AssumeRole"
+  }]
+}`,
+			ExpectError: true,
+		},
+		{
+			Name: "other service principal",
+			Policy: `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {"Service": "eks.amazonaws.com"},
+    "Action": ["sts:AssumeRole", "sts:TagSession"]
+  }]
+}`,
+			ExpectError: true,
+		},
+		{
+			Name: "deny",
+			Policy: `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Deny",
+    "Principal": {"Service": "pods.eks.amazonaws.com"},
+    "Action": ["sts:AssumeRole", "sts:TagSession"]
+  }]
+}`,
+			ExpectError: true,
+		},
+		{
+			Name:        "invalid JSON",
+			Policy:      `{`,
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validPodIdentityRoleTrustPolicy(testCase.Policy)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatal("expected error, got none")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,339 @@
+package guardduty
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_guardduty_malware_protection_plan", name="Malware Protection Plan")
+// @Tags(identifierAttribute="arn")
+func ResourceMalwareProtectionPlan() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceMalwareProtectionPlanCreate,
+		ReadWithoutTimeout:   resourceMalwareProtectionPlanRead,
+		UpdateWithoutTimeout: resourceMalwareProtectionPlanUpdate,
+		DeleteWithoutTimeout: resourceMalwareProtectionPlanDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"actions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tagging": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"status": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(guardduty.MalwareProtectionPlanTaggingActionStatus_Values(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"protected_resource": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"s3_bucket": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket_name": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"object_prefixes": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										MaxItems: 5,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"role": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceMalwareProtectionPlanCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GuardDutyConn(ctx)
+
+	input := &guardduty.CreateMalwareProtectionPlanInput{
+		ProtectedResource: expandCreateProtectedResource(d.Get("protected_resource").([]interface{})),
+		Role:              aws.String(d.Get("role").(string)),
+		Tags:              getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("actions"); ok && len(v.([]interface{})) > 0 {
+		input.Actions = expandMalwareProtectionPlanActions(v.([]interface{}))
+	}
+
+	output, err := conn.CreateMalwareProtectionPlanWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating GuardDuty Malware Protection Plan: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.MalwareProtectionPlanId))
+
+	return append(diags, resourceMalwareProtectionPlanRead(ctx, d, meta)...)
+}
+
+func resourceMalwareProtectionPlanRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GuardDutyConn(ctx)
+
+	output, err := FindMalwareProtectionPlanByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] GuardDuty Malware Protection Plan (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading GuardDuty Malware Protection Plan (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("actions", flattenMalwareProtectionPlanActions(output.Actions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting actions: %s", err)
+	}
+	d.Set("arn", output.Arn)
+	d.Set("created_at", aws.TimeValue(output.CreatedAt).Format(time.RFC3339))
+	if err := d.Set("protected_resource", flattenCreateProtectedResource(output.ProtectedResource)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting protected_resource: %s", err)
+	}
+	d.Set("role", output.Role)
+	d.Set("status", output.Status)
+
+	setTagsOut(ctx, output.Tags)
+
+	return diags
+}
+
+func resourceMalwareProtectionPlanUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GuardDutyConn(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &guardduty.UpdateMalwareProtectionPlanInput{
+			MalwareProtectionPlanId: aws.String(d.Id()),
+			Role:                    aws.String(d.Get("role").(string)),
+		}
+
+		if d.HasChange("actions") {
+			input.Actions = expandMalwareProtectionPlanActions(d.Get("actions").([]interface{}))
+		}
+
+		if d.HasChange("protected_resource") {
+			input.ProtectedResource = expandUpdateProtectedResource(d.Get("protected_resource").([]interface{}))
+		}
+
+		_, err := conn.UpdateMalwareProtectionPlanWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating GuardDuty Malware Protection Plan (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceMalwareProtectionPlanRead(ctx, d, meta)...)
+}
+
+func resourceMalwareProtectionPlanDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GuardDutyConn(ctx)
+
+	log.Printf("[DEBUG] Deleting GuardDuty Malware Protection Plan: %s", d.Id())
+	_, err := conn.DeleteMalwareProtectionPlanWithContext(ctx, &guardduty.DeleteMalwareProtectionPlanInput{
+		MalwareProtectionPlanId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, guardduty.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting GuardDuty Malware Protection Plan (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindMalwareProtectionPlanByID(ctx context.Context, conn *guardduty.GuardDuty, id string) (*guardduty.GetMalwareProtectionPlanOutput, error) {
+	input := &guardduty.GetMalwareProtectionPlanInput{
+		MalwareProtectionPlanId: aws.String(id),
+	}
+
+	output, err := conn.GetMalwareProtectionPlanWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, guardduty.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandMalwareProtectionPlanActions(tfList []interface{}) *guardduty.MalwareProtectionPlanActions {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &guardduty.MalwareProtectionPlanActions{}
+
+	if v, ok := tfMap["tagging"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Tagging = &guardduty.MalwareProtectionPlanTaggingAction{
+			Status: aws.String(v[0].(map[string]interface{})["status"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func expandCreateProtectedResource(tfList []interface{}) *guardduty.CreateProtectedResource {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &guardduty.CreateProtectedResource{}
+
+	if v, ok := tfMap["s3_bucket"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.S3Bucket = &guardduty.CreateS3BucketResource{
+			BucketName: aws.String(tfMap["bucket_name"].(string)),
+		}
+
+		if v, ok := tfMap["object_prefixes"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.S3Bucket.ObjectPrefixes = flex.ExpandStringSet(v)
+		}
+	}
+
+	return apiObject
+}
+
+func expandUpdateProtectedResource(tfList []interface{}) *guardduty.UpdateProtectedResource {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &guardduty.UpdateProtectedResource{}
+
+	if v, ok := tfMap["s3_bucket"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		// An empty list removes all object prefixes.
+		apiObject.S3Bucket = &guardduty.UpdateS3BucketResource{
+			ObjectPrefixes: aws.StringSlice([]string{}),
+		}
+
+		if v, ok := tfMap["object_prefixes"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.S3Bucket.ObjectPrefixes = flex.ExpandStringSet(v)
+		}
+	}
+
+	return apiObject
+}
+
+func flattenMalwareProtectionPlanActions(apiObject *guardduty.MalwareProtectionPlanActions) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Tagging; v != nil {
+		tfMap["tagging"] = []interface{}{map[string]interface{}{
+			"status": aws.StringValue(v.Status),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenCreateProtectedResource(apiObject *guardduty.CreateProtectedResource) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.S3Bucket; v != nil {
+		tfMap["s3_bucket"] = []interface{}{map[string]interface{}{
+			"bucket_name":     aws.StringValue(v.BucketName),
+			"object_prefixes": aws.StringValueSlice(v.ObjectPrefixes),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,389 @@
+package guardduty_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfguardduty "github.com/hashicorp/terraform-provider-aws/internal/service/guardduty"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccGuardDutyMalwareProtectionPlan_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v guardduty.GetMalwareProtectionPlanOutput
+	resourceName := "aws_guardduty_malware_protection_plan.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, guardduty.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMalwareProtectionPlanDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMalwareProtectionPlanConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMalwareProtectionPlanExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "actions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "actions.0.tagging.0.status", "DISABLED"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttr(resourceName, "protected_resource.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "protected_resource.0.s3_bucket.0.bucket_name", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "protected_resource.0.s3_bucket.0.object_prefixes.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "role", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGuardDutyMalwareProtectionPlan_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v guardduty.GetMalwareProtectionPlanOutput
+	resourceName := "aws_guardduty_malware_protection_plan.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, guardduty.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMalwareProtectionPlanDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMalwareProtectionPlanConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMalwareProtectionPlanExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfguardduty.ResourceMalwareProtectionPlan(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccGuardDutyMalwareProtectionPlan_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v guardduty.GetMalwareProtectionPlanOutput
+	resourceName := "aws_guardduty_malware_protection_plan.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, guardduty.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMalwareProtectionPlanDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMalwareProtectionPlanConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMalwareProtectionPlanExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "actions.0.tagging.0.status", "DISABLED"),
+					resource.TestCheckResourceAttr(resourceName, "protected_resource.0.s3_bucket.0.object_prefixes.#", "0"),
+				),
+			},
+			{
+				Config: testAccMalwareProtectionPlanConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMalwareProtectionPlanExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "actions.0.tagging.0.status", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "protected_resource.0.s3_bucket.0.object_prefixes.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "protected_resource.0.s3_bucket.0.object_prefixes.*", "incoming/"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "protected_resource.0.s3_bucket.0.object_prefixes.*", "uploads/"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGuardDutyMalwareProtectionPlan_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v guardduty.GetMalwareProtectionPlanOutput
+	resourceName := "aws_guardduty_malware_protection_plan.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, guardduty.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMalwareProtectionPlanDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMalwareProtectionPlanConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMalwareProtectionPlanExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMalwareProtectionPlanConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMalwareProtectionPlanExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccMalwareProtectionPlanConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMalwareProtectionPlanExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMalwareProtectionPlanExists(ctx context.Context, n string, v *guardduty.GetMalwareProtectionPlanOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No GuardDuty Malware Protection Plan ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GuardDutyConn(ctx)
+
+		output, err := tfguardduty.FindMalwareProtectionPlanByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckMalwareProtectionPlanDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GuardDutyConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_guardduty_malware_protection_plan" {
+				continue
+			}
+
+			_, err := tfguardduty.FindMalwareProtectionPlanByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("GuardDuty Malware Protection Plan %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccMalwareProtectionPlanConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "malware-protection-plan.guardduty.${data.aws_partition.current.dns_suffix}"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Sid    = "AllowManagedRuleToSendS3EventsToGuardDuty"
+        Effect = "Allow"
+        Action = [
+          "events:PutRule",
+          "events:DeleteRule",
+          "events:PutTargets",
+          "events:RemoveTargets",
+        ]
+        Resource = "arn:${data.aws_partition.current.partition}:events:*:${data.aws_caller_identity.current.account_id}:rule/DO-NOT-DELETE-AmazonGuardDutyMalwareProtectionS3*"
+        Condition = {
+          StringLike = {
+            "events:ManagedBy" = "malware-protection-plan.guardduty.${data.aws_partition.current.dns_suffix}"
+          }
+        }
+      },
+      {
+        Sid      = "AllowGuardDutyToMonitorEventBridgeManagedRule"
+        Effect   = "Allow"
+        Action   = ["events:DescribeRule", "events:ListTargetsByRule"]
+        Resource = "arn:${data.aws_partition.current.partition}:events:*:${data.aws_caller_identity.current.account_id}:rule/DO-NOT-DELETE-AmazonGuardDutyMalwareProtectionS3*"
+      },
+      {
+        Sid    = "AllowPostScanTag"
+        Effect = "Allow"
+        Action = [
+          "s3:PutObjectTagging",
+          "s3:GetObjectTagging",
+          "s3:PutObjectVersionTagging",
+          "s3:GetObjectVersionTagging",
+        ]
+        Resource = "${aws_s3_bucket.test.arn}/*"
+      },
+      {
+        Sid      = "AllowEnableS3EventBridgeEvents"
+        Effect   = "Allow"
+        Action   = ["s3:PutBucketNotification", "s3:GetBucketNotification"]
+        Resource = aws_s3_bucket.test.arn
+      },
+      {
+        Sid      = "AllowPutValidationObject"
+        Effect   = "Allow"
+        Action   = ["s3:PutObject"]
+        Resource = "${aws_s3_bucket.test.arn}/malware-protection-resource-validation-object"
+      },
+      {
+        Sid      = "AllowCheckBucketOwnership"
+        Effect   = "Allow"
+        Action   = ["s3:ListBucket"]
+        Resource = aws_s3_bucket.test.arn
+      },
+      {
+        Sid      = "AllowMalwareScan"
+        Effect   = "Allow"
+        Action   = ["s3:GetObject", "s3:GetObjectVersion"]
+        Resource = "${aws_s3_bucket.test.arn}/*"
+      },
+    ]
+  })
+}
+`, rName)
+}
+
+func testAccMalwareProtectionPlanConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccMalwareProtectionPlanConfig_base(rName), `
+resource "aws_guardduty_malware_protection_plan" "test" {
+  role = aws_iam_role.test.arn
+
+  protected_resource {
+    s3_bucket {
+      bucket_name = aws_s3_bucket.test.bucket
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`)
+}
+
+func testAccMalwareProtectionPlanConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccMalwareProtectionPlanConfig_base(rName), `
+resource "aws_guardduty_malware_protection_plan" "test" {
+  role = aws_iam_role.test.arn
+
+  protected_resource {
+    s3_bucket {
+      bucket_name     = aws_s3_bucket.test.bucket
+      object_prefixes = ["incoming/", "uploads/"]
+    }
+  }
+
+  actions {
+    tagging {
+      status = "ENABLED"
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`)
+}
+
+func testAccMalwareProtectionPlanConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccMalwareProtectionPlanConfig_base(rName), fmt.Sprintf(`
+resource "aws_guardduty_malware_protection_plan" "test" {
+  role = aws_iam_role.test.arn
+
+  protected_resource {
+    s3_bucket {
+      bucket_name = aws_s3_bucket.test.bucket
+    }
+  }
+
+  tags = {
+    %[1]q = %[2]q
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccMalwareProtectionPlanConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccMalwareProtectionPlanConfig_base(rName), fmt.Sprintf(`
+resource "aws_guardduty_malware_protection_plan" "test" {
+  role = aws_iam_role.test.arn
+
+  protected_resource {
+    s3_bucket {
+      bucket_name = aws_s3_bucket.test.bucket
+    }
+  }
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
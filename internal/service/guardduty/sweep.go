@@ -26,6 +26,11 @@ func init() {
 		Name: "aws_guardduty_publishing_destination",
 		F:    sweepPublishingDestinations,
 	})
+
+	resource.AddTestSweepers("aws_guardduty_malware_protection_plan", &resource.Sweeper{
+		Name: "aws_guardduty_malware_protection_plan",
+		F:    sweepMalwareProtectionPlans,
+	})
 }
 
 func sweepDetectors(region string) error {
@@ -133,3 +138,49 @@ func sweepPublishingDestinations(region string) error {
 
 	return sweeperErrs.ErrorOrNil()
 }
+
+func sweepMalwareProtectionPlans(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.GuardDutyConn(ctx)
+	input := &guardduty.ListMalwareProtectionPlansInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	for {
+		output, err := conn.ListMalwareProtectionPlansWithContext(ctx, input)
+
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping GuardDuty Malware Protection Plan sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing GuardDuty Malware Protection Plans (%s): %w", region, err)
+		}
+
+		for _, v := range output.MalwareProtectionPlans {
+			r := ResourceMalwareProtectionPlan()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.MalwareProtectionPlanId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping GuardDuty Malware Protection Plans (%s): %w", region, err)
+	}
+
+	return nil
+}
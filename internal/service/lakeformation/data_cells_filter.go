@@ -0,0 +1,381 @@
+package lakeformation
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_lakeformation_data_cells_filter", name="Data Cells Filter")
+func ResourceDataCellsFilter() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDataCellsFilterCreate,
+		ReadWithoutTimeout:   resourceDataCellsFilterRead,
+		UpdateWithoutTimeout: resourceDataCellsFilterUpdate,
+		DeleteWithoutTimeout: resourceDataCellsFilterDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"table_data": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"column_names": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.NoZeroValues,
+							},
+							ConflictsWith: []string{"table_data.0.column_wildcard"},
+						},
+						"column_wildcard": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"excluded_column_names": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.NoZeroValues,
+										},
+									},
+								},
+							},
+							ConflictsWith: []string{"table_data.0.column_names"},
+						},
+						"database_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"row_filter": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"all_rows_wildcard": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{},
+										},
+										ExactlyOneOf: []string{
+											"table_data.0.row_filter.0.all_rows_wildcard",
+											"table_data.0.row_filter.0.filter_expression",
+										},
+									},
+									"filter_expression": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ExactlyOneOf: []string{
+											"table_data.0.row_filter.0.all_rows_wildcard",
+											"table_data.0.row_filter.0.filter_expression",
+										},
+									},
+								},
+							},
+						},
+						"table_catalog_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+						"table_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"version_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+const (
+	dataCellsFilterIDPartCount = 4
+)
+
+func resourceDataCellsFilterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationConn(ctx)
+
+	tableData := expandDataCellsFilter(d.Get("table_data").([]interface{}))
+	idParts := []string{
+		aws.StringValue(tableData.TableCatalogId),
+		aws.StringValue(tableData.DatabaseName),
+		aws.StringValue(tableData.TableName),
+		aws.StringValue(tableData.Name),
+	}
+	id, err := flex.FlattenResourceId(idParts, dataCellsFilterIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &lakeformation.CreateDataCellsFilterInput{
+		TableData: tableData,
+	}
+
+	_, err = conn.CreateDataCellsFilterWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Lake Formation Data Cells Filter (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceDataCellsFilterRead(ctx, d, meta)...)
+}
+
+func resourceDataCellsFilterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationConn(ctx)
+
+	idParts, err := flex.ExpandResourceId(d.Id(), dataCellsFilterIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	filter, err := FindDataCellsFilterByFourPartKey(ctx, conn, idParts[0], idParts[1], idParts[2], idParts[3])
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lake Formation Data Cells Filter (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lake Formation Data Cells Filter (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("table_data", flattenDataCellsFilter(filter)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting table_data: %s", err)
+	}
+
+	return diags
+}
+
+func resourceDataCellsFilterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationConn(ctx)
+
+	tableData := expandDataCellsFilter(d.Get("table_data").([]interface{}))
+	tableData.VersionId = aws.String(d.Get("table_data.0.version_id").(string))
+
+	input := &lakeformation.UpdateDataCellsFilterInput{
+		TableData: tableData,
+	}
+
+	_, err := conn.UpdateDataCellsFilterWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Lake Formation Data Cells Filter (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceDataCellsFilterRead(ctx, d, meta)...)
+}
+
+func resourceDataCellsFilterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationConn(ctx)
+
+	idParts, err := flex.ExpandResourceId(d.Id(), dataCellsFilterIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting Lake Formation Data Cells Filter: %s", d.Id())
+	_, err = conn.DeleteDataCellsFilterWithContext(ctx, &lakeformation.DeleteDataCellsFilterInput{
+		DatabaseName:   aws.String(idParts[1]),
+		Name:           aws.String(idParts[3]),
+		TableCatalogId: aws.String(idParts[0]),
+		TableName:      aws.String(idParts[2]),
+	})
+
+	if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Lake Formation Data Cells Filter (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindDataCellsFilterByFourPartKey(ctx context.Context, conn *lakeformation.LakeFormation, tableCatalogID, databaseName, tableName, name string) (*lakeformation.DataCellsFilter, error) {
+	input := &lakeformation.GetDataCellsFilterInput{
+		DatabaseName:   aws.String(databaseName),
+		Name:           aws.String(name),
+		TableCatalogId: aws.String(tableCatalogID),
+		TableName:      aws.String(tableName),
+	}
+
+	output, err := conn.GetDataCellsFilterWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DataCellsFilter == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.DataCellsFilter, nil
+}
+
+func expandDataCellsFilter(tfList []interface{}) *lakeformation.DataCellsFilter {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &lakeformation.DataCellsFilter{}
+
+	if v, ok := tfMap["column_names"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ColumnNames = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["column_wildcard"].([]interface{}); ok && len(v) > 0 {
+		apiObject.ColumnWildcard = expandDataCellsFilterColumnWildcard(v)
+	}
+
+	if v, ok := tfMap["database_name"].(string); ok && v != "" {
+		apiObject.DatabaseName = aws.String(v)
+	}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap["row_filter"].([]interface{}); ok && len(v) > 0 {
+		apiObject.RowFilter = expandDataCellsFilterRowFilter(v)
+	}
+
+	if v, ok := tfMap["table_catalog_id"].(string); ok && v != "" {
+		apiObject.TableCatalogId = aws.String(v)
+	}
+
+	if v, ok := tfMap["table_name"].(string); ok && v != "" {
+		apiObject.TableName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandDataCellsFilterColumnWildcard(tfList []interface{}) *lakeformation.ColumnWildcard {
+	apiObject := &lakeformation.ColumnWildcard{}
+
+	// An empty column_wildcard block is valid and selects all columns.
+	if len(tfList) == 0 || tfList[0] == nil {
+		return apiObject
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["excluded_column_names"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ExcludedColumnNames = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func expandDataCellsFilterRowFilter(tfList []interface{}) *lakeformation.RowFilter {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &lakeformation.RowFilter{}
+
+	if v, ok := tfMap["all_rows_wildcard"].([]interface{}); ok && len(v) > 0 {
+		apiObject.AllRowsWildcard = &lakeformation.AllRowsWildcard{}
+	}
+
+	if v, ok := tfMap["filter_expression"].(string); ok && v != "" {
+		apiObject.FilterExpression = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenDataCellsFilter(apiObject *lakeformation.DataCellsFilter) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"column_names":     aws.StringValueSlice(apiObject.ColumnNames),
+		"database_name":    aws.StringValue(apiObject.DatabaseName),
+		"name":             aws.StringValue(apiObject.Name),
+		"table_catalog_id": aws.StringValue(apiObject.TableCatalogId),
+		"table_name":       aws.StringValue(apiObject.TableName),
+		"version_id":       aws.StringValue(apiObject.VersionId),
+	}
+
+	if v := apiObject.ColumnWildcard; v != nil {
+		tfMap["column_wildcard"] = []interface{}{map[string]interface{}{
+			"excluded_column_names": aws.StringValueSlice(v.ExcludedColumnNames),
+		}}
+	}
+
+	if v := apiObject.RowFilter; v != nil {
+		tfMapRowFilter := map[string]interface{}{
+			"filter_expression": aws.StringValue(v.FilterExpression),
+		}
+
+		if v.AllRowsWildcard != nil {
+			tfMapRowFilter["all_rows_wildcard"] = []interface{}{map[string]interface{}{}}
+		}
+
+		tfMap["row_filter"] = []interface{}{tfMapRowFilter}
+	}
+
+	return []interface{}{tfMap}
+}
@@ -23,6 +23,10 @@ func FilterPermissions(input *lakeformation.ListPermissionsInput, tableType stri
 		return FilterCatalogPermissions(input.Principal.DataLakePrincipalIdentifier, allPermissions)
 	}
 
+	if input.Resource.DataCellsFilter != nil {
+		return FilterDataCellsFilterPermissions(input.Principal.DataLakePrincipalIdentifier, input.Resource.DataCellsFilter, allPermissions)
+	}
+
 	if input.Resource.DataLocation != nil {
 		return FilterDataLocationPermissions(input.Principal.DataLakePrincipalIdentifier, allPermissions)
 	}
@@ -153,6 +157,26 @@ func FilterCatalogPermissions(principal *string, allPermissions []*lakeformation
 	return cleanPermissions
 }
 
+func FilterDataCellsFilterPermissions(principal *string, filter *lakeformation.DataCellsFilterResource, allPermissions []*lakeformation.PrincipalResourcePermissions) []*lakeformation.PrincipalResourcePermissions {
+	var cleanPermissions []*lakeformation.PrincipalResourcePermissions
+
+	for _, perm := range allPermissions {
+		if aws.StringValue(principal) != aws.StringValue(perm.Principal.DataLakePrincipalIdentifier) {
+			continue
+		}
+
+		if v := perm.Resource.DataCellsFilter; v != nil &&
+			aws.StringValue(v.DatabaseName) == aws.StringValue(filter.DatabaseName) &&
+			aws.StringValue(v.Name) == aws.StringValue(filter.Name) &&
+			aws.StringValue(v.TableCatalogId) == aws.StringValue(filter.TableCatalogId) &&
+			aws.StringValue(v.TableName) == aws.StringValue(filter.TableName) {
+			cleanPermissions = append(cleanPermissions, perm)
+		}
+	}
+
+	return cleanPermissions
+}
+
 func FilterDataLocationPermissions(principal *string, allPermissions []*lakeformation.PrincipalResourcePermissions) []*lakeformation.PrincipalResourcePermissions {
 	var cleanPermissions []*lakeformation.PrincipalResourcePermissions
 
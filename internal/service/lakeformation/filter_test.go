@@ -536,6 +536,76 @@ func TestFilterPermissions(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "dataCellsFilter",
+			Input: &lakeformation.ListPermissionsInput{
+				Principal: principal,
+				Resource: &lakeformation.Resource{
+					DataCellsFilter: &lakeformation.DataCellsFilterResource{
+						DatabaseName:   aws.String(dbName),
+						Name:           aws.String("Bagwumi"),
+						TableCatalogId: aws.String(accountID),
+						TableName:      aws.String(tableName),
+					},
+				},
+			},
+			All: []*lakeformation.PrincipalResourcePermissions{
+				{
+					Permissions:                aws.StringSlice([]string{lakeformation.PermissionSelect}),
+					PermissionsWithGrantOption: aws.StringSlice([]string{}),
+					Principal:                  principal,
+					Resource: &lakeformation.Resource{
+						DataCellsFilter: &lakeformation.DataCellsFilterResource{
+							DatabaseName:   aws.String(dbName),
+							Name:           aws.String("Bagwumi"),
+							TableCatalogId: aws.String(accountID),
+							TableName:      aws.String(tableName),
+						},
+					},
+				},
+				{
+					Permissions:                aws.StringSlice([]string{lakeformation.PermissionSelect}),
+					PermissionsWithGrantOption: aws.StringSlice([]string{}),
+					Principal:                  principal,
+					Resource: &lakeformation.Resource{
+						DataCellsFilter: &lakeformation.DataCellsFilterResource{
+							DatabaseName:   aws.String(dbName),
+							Name:           aws.String("Zonetu"),
+							TableCatalogId: aws.String(accountID),
+							TableName:      aws.String(tableName),
+						},
+					},
+				},
+				{
+					Permissions:                aws.StringSlice([]string{lakeformation.PermissionSelect}),
+					PermissionsWithGrantOption: aws.StringSlice([]string{}),
+					Principal:                  principal,
+					Resource: &lakeformation.Resource{
+						DataCellsFilter: &lakeformation.DataCellsFilterResource{
+							DatabaseName:   aws.String(altDBName),
+							Name:           aws.String("Bagwumi"),
+							TableCatalogId: aws.String(accountID),
+							TableName:      aws.String(tableName),
+						},
+					},
+				},
+			},
+			ExpectedClean: []*lakeformation.PrincipalResourcePermissions{
+				{
+					Permissions:                aws.StringSlice([]string{lakeformation.PermissionSelect}),
+					PermissionsWithGrantOption: aws.StringSlice([]string{}),
+					Principal:                  principal,
+					Resource: &lakeformation.Resource{
+						DataCellsFilter: &lakeformation.DataCellsFilterResource{
+							DatabaseName:   aws.String(dbName),
+							Name:           aws.String("Bagwumi"),
+							TableCatalogId: aws.String(accountID),
+							TableName:      aws.String(tableName),
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
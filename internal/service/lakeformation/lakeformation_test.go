@@ -10,6 +10,12 @@ func TestAccLakeFormation_serial(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]map[string]func(t *testing.T){
+		"DataCellsFilter": {
+			"basic":          testAccDataCellsFilter_basic,
+			"columnWildcard": testAccDataCellsFilter_columnWildcard,
+			"disappears":     testAccDataCellsFilter_disappears,
+			"rowFilter":      testAccDataCellsFilter_rowFilter,
+		},
 		"DataLakeSettings": {
 			"basic":            testAccDataLakeSettings_basic,
 			"dataSource":       testAccDataLakeSettingsDataSource_basic,
@@ -21,6 +27,7 @@ func TestAccLakeFormation_serial(t *testing.T) {
 			"database":            testAccPermissions_database,
 			"databaseIAMAllowed":  testAccPermissions_databaseIAMAllowed,
 			"databaseMultiple":    testAccPermissions_databaseMultiple,
+			"dataCellsFilter":     testAccPermissions_dataCellsFilter,
 			"dataLocation":        testAccPermissions_dataLocation,
 			"disappears":          testAccPermissions_disappears,
 			"lfTag":               testAccPermissions_lfTag,
@@ -30,6 +37,7 @@ func TestAccLakeFormation_serial(t *testing.T) {
 		"PermissionsDataSource": {
 			"basic":            testAccPermissionsDataSource_basic,
 			"database":         testAccPermissionsDataSource_database,
+			"dataCellsFilter":  testAccPermissionsDataSource_dataCellsFilter,
 			"dataLocation":     testAccPermissionsDataSource_dataLocation,
 			"lfTag":            testAccPermissionsDataSource_lfTag,
 			"lfTagPolicy":      testAccPermissionsDataSource_lfTagPolicy,
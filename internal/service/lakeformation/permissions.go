@@ -22,6 +22,17 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+var permissionsResourceTypes = []string{
+	"catalog_resource",
+	"data_cells_filter",
+	"data_location",
+	"database",
+	"lf_tag",
+	"lf_tag_policy",
+	"table",
+	"table_with_columns",
+}
+
 // @SDKResource("aws_lakeformation_permissions")
 func ResourcePermissions() *schema.Resource {
 	return &schema.Resource{
@@ -37,35 +48,51 @@ func ResourcePermissions() *schema.Resource {
 				ValidateFunc: verify.ValidAccountID,
 			},
 			"catalog_resource": {
-				Type:     schema.TypeBool,
-				Default:  false,
-				ForceNew: true,
-				Optional: true,
-				ExactlyOneOf: []string{
-					"catalog_resource",
-					"data_location",
-					"database",
-					"lf_tag",
-					"lf_tag_policy",
-					"table",
-					"table_with_columns",
+				Type:         schema.TypeBool,
+				Default:      false,
+				ForceNew:     true,
+				Optional:     true,
+				ExactlyOneOf: permissionsResourceTypes,
+			},
+			"data_cells_filter": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: permissionsResourceTypes,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"database_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"table_catalog_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+						"table_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
 				},
 			},
 			"data_location": {
-				Type:     schema.TypeList,
-				Computed: true,
-				ForceNew: true,
-				MaxItems: 1,
-				Optional: true,
-				ExactlyOneOf: []string{
-					"catalog_resource",
-					"data_location",
-					"database",
-					"lf_tag",
-					"lf_tag_policy",
-					"table",
-					"table_with_columns",
-				},
+				Type:         schema.TypeList,
+				Computed:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				Optional:     true,
+				ExactlyOneOf: permissionsResourceTypes,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"arn": {
@@ -85,20 +112,12 @@ func ResourcePermissions() *schema.Resource {
 				},
 			},
 			"database": {
-				Type:     schema.TypeList,
-				Computed: true,
-				ForceNew: true,
-				MaxItems: 1,
-				Optional: true,
-				ExactlyOneOf: []string{
-					"catalog_resource",
-					"data_location",
-					"database",
-					"lf_tag",
-					"lf_tag_policy",
-					"table",
-					"table_with_columns",
-				},
+				Type:         schema.TypeList,
+				Computed:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				Optional:     true,
+				ExactlyOneOf: permissionsResourceTypes,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"catalog_id": {
@@ -117,20 +136,12 @@ func ResourcePermissions() *schema.Resource {
 				},
 			},
 			"lf_tag": {
-				Type:     schema.TypeList,
-				Optional: true,
-				Computed: true,
-				ForceNew: true,
-				MaxItems: 1,
-				ExactlyOneOf: []string{
-					"catalog_resource",
-					"data_location",
-					"database",
-					"lf_tag",
-					"lf_tag_policy",
-					"table",
-					"table_with_columns",
-				},
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: permissionsResourceTypes,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"catalog_id": {
@@ -160,20 +171,12 @@ func ResourcePermissions() *schema.Resource {
 				},
 			},
 			"lf_tag_policy": {
-				Type:     schema.TypeList,
-				Optional: true,
-				Computed: true,
-				ForceNew: true,
-				MaxItems: 1,
-				ExactlyOneOf: []string{
-					"catalog_resource",
-					"data_location",
-					"database",
-					"lf_tag",
-					"lf_tag_policy",
-					"table",
-					"table_with_columns",
-				},
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: permissionsResourceTypes,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"catalog_id": {
@@ -244,20 +247,12 @@ func ResourcePermissions() *schema.Resource {
 				ValidateFunc: validPrincipal,
 			},
 			"table": {
-				Type:     schema.TypeList,
-				Computed: true,
-				ForceNew: true,
-				MaxItems: 1,
-				Optional: true,
-				ExactlyOneOf: []string{
-					"catalog_resource",
-					"data_location",
-					"database",
-					"lf_tag",
-					"lf_tag_policy",
-					"table",
-					"table_with_columns",
-				},
+				Type:         schema.TypeList,
+				Computed:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				Optional:     true,
+				ExactlyOneOf: permissionsResourceTypes,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"catalog_id": {
@@ -296,20 +291,12 @@ func ResourcePermissions() *schema.Resource {
 				},
 			},
 			"table_with_columns": {
-				Type:     schema.TypeList,
-				Computed: true,
-				ForceNew: true,
-				MaxItems: 1,
-				Optional: true,
-				ExactlyOneOf: []string{
-					"catalog_resource",
-					"data_location",
-					"database",
-					"lf_tag",
-					"lf_tag_policy",
-					"table",
-					"table_with_columns",
-				},
+				Type:         schema.TypeList,
+				Computed:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				Optional:     true,
+				ExactlyOneOf: permissionsResourceTypes,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"catalog_id": {
@@ -403,6 +390,10 @@ func resourcePermissionsCreate(ctx context.Context, d *schema.ResourceData, meta
 		input.Resource.Catalog = ExpandCatalogResource()
 	}
 
+	if v, ok := d.GetOk("data_cells_filter"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Resource.DataCellsFilter = ExpandDataCellsFilterResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("data_location"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		input.Resource.DataLocation = ExpandDataLocationResource(v.([]interface{})[0].(map[string]interface{}))
 	}
@@ -489,6 +480,10 @@ func resourcePermissionsRead(ctx context.Context, d *schema.ResourceData, meta i
 		input.Resource.Catalog = ExpandCatalogResource()
 	}
 
+	if v, ok := d.GetOk("data_cells_filter"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Resource.DataCellsFilter = ExpandDataCellsFilterResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("data_location"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		input.Resource.DataLocation = ExpandDataLocationResource(v.([]interface{})[0].(map[string]interface{}))
 	}
@@ -574,6 +569,7 @@ func resourcePermissionsRead(ctx context.Context, d *schema.ResourceData, meta i
 	if len(cleanPermissions) == 0 {
 		log.Printf("[WARN] No Lake Formation permissions (%s) found", d.Id())
 		d.Set("catalog_resource", false)
+		d.Set("data_cells_filter", nil)
 		d.Set("data_location", nil)
 		d.Set("database", nil)
 		d.Set("lf_tag", nil)
@@ -597,6 +593,14 @@ func resourcePermissionsRead(ctx context.Context, d *schema.ResourceData, meta i
 		d.Set("catalog_resource", false)
 	}
 
+	if cleanPermissions[0].Resource.DataCellsFilter != nil {
+		if err := d.Set("data_cells_filter", []interface{}{flattenDataCellsFilterResource(cleanPermissions[0].Resource.DataCellsFilter)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting data_cells_filter: %s", err)
+		}
+	} else {
+		d.Set("data_cells_filter", nil)
+	}
+
 	if cleanPermissions[0].Resource.DataLocation != nil {
 		if err := d.Set("data_location", []interface{}{flattenDataLocationResource(cleanPermissions[0].Resource.DataLocation)}); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting data_location: %s", err)
@@ -703,6 +707,10 @@ func resourcePermissionsDelete(ctx context.Context, d *schema.ResourceData, meta
 		input.Resource.Catalog = ExpandCatalogResource()
 	}
 
+	if v, ok := d.GetOk("data_cells_filter"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Resource.DataCellsFilter = ExpandDataCellsFilterResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("data_location"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		input.Resource.DataLocation = ExpandDataLocationResource(v.([]interface{})[0].(map[string]interface{}))
 	}
@@ -806,6 +814,58 @@ func ExpandCatalogResource() *lakeformation.CatalogResource {
 	return &lakeformation.CatalogResource{}
 }
 
+func ExpandDataCellsFilterResource(tfMap map[string]interface{}) *lakeformation.DataCellsFilterResource {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &lakeformation.DataCellsFilterResource{}
+
+	if v, ok := tfMap["database_name"].(string); ok && v != "" {
+		apiObject.DatabaseName = aws.String(v)
+	}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap["table_catalog_id"].(string); ok && v != "" {
+		apiObject.TableCatalogId = aws.String(v)
+	}
+
+	if v, ok := tfMap["table_name"].(string); ok && v != "" {
+		apiObject.TableName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenDataCellsFilterResource(apiObject *lakeformation.DataCellsFilterResource) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.DatabaseName; v != nil {
+		tfMap["database_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Name; v != nil {
+		tfMap["name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.TableCatalogId; v != nil {
+		tfMap["table_catalog_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.TableName; v != nil {
+		tfMap["table_name"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
 func ExpandDataLocationResource(tfMap map[string]interface{}) *lakeformation.DataLocationResource {
 	if tfMap == nil {
 		return nil
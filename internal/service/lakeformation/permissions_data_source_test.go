@@ -35,6 +35,32 @@ func testAccPermissionsDataSource_basic(t *testing.T) {
 	})
 }
 
+func testAccPermissionsDataSource_dataCellsFilter(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_permissions.test"
+	dataSourceName := "data.aws_lakeformation_permissions.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, lakeformation.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPermissionsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionsDataSourceConfig_dataCellsFilter(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "principal", dataSourceName, "principal"),
+					resource.TestCheckResourceAttrPair(resourceName, "permissions.#", dataSourceName, "permissions.#"),
+					resource.TestCheckResourceAttrPair(resourceName, "permissions.0", dataSourceName, "permissions.0"),
+					resource.TestCheckResourceAttrPair(resourceName, "data_cells_filter.#", dataSourceName, "data_cells_filter.#"),
+					resource.TestCheckResourceAttrPair(resourceName, "data_cells_filter.0.name", dataSourceName, "data_cells_filter.0.name"),
+				),
+			},
+		},
+	})
+}
+
 func testAccPermissionsDataSource_dataLocation(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -319,6 +345,21 @@ data "aws_lakeformation_permissions" "test" {
 `, rName)
 }
 
+func testAccPermissionsDataSourceConfig_dataCellsFilter(rName string) string {
+	return acctest.ConfigCompose(testAccPermissionsConfig_dataCellsFilter(rName), `
+data "aws_lakeformation_permissions" "test" {
+  principal = aws_lakeformation_permissions.test.principal
+
+  data_cells_filter {
+    database_name    = aws_lakeformation_permissions.test.data_cells_filter[0].database_name
+    name             = aws_lakeformation_permissions.test.data_cells_filter[0].name
+    table_catalog_id = aws_lakeformation_permissions.test.data_cells_filter[0].table_catalog_id
+    table_name       = aws_lakeformation_permissions.test.data_cells_filter[0].table_name
+  }
+}
+`)
+}
+
 func testAccPermissionsDataSourceConfig_database(rName string) string {
 	return fmt.Sprintf(`
 data "aws_partition" "current" {}
@@ -0,0 +1,198 @@
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+const (
+	// Maximum size of a ZIP deployment package uploaded directly to Lambda.
+	// See https://docs.aws.amazon.com/lambda/latest/dg/gettingstarted-limits.html.
+	directUploadMaxSize = 50 * 1024 * 1024
+)
+
+// Modification time used for every ZIP archive entry so that archives don't depend on file system timestamps.
+var zipEntryModified = time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+type sourceDirPackage struct {
+	S3Bucket string
+	S3Key    string
+	ZipFile  []byte
+}
+
+// packageSourceDir builds a ZIP deployment package from the contents of the specified directory.
+// Packages that are too large to be uploaded directly are uploaded to the specified staging S3 bucket.
+func packageSourceDir(ctx context.Context, conn *s3.S3, dir, stagingBucket, name string) (*sourceDirPackage, error) {
+	zipFile, err := zipDirectory(dir)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(zipFile) <= directUploadMaxSize {
+		return &sourceDirPackage{ZipFile: zipFile}, nil
+	}
+
+	if stagingBucket == "" {
+		return nil, fmt.Errorf("deployment package size (%d bytes) exceeds the direct upload limit (%d bytes), set source_dir_staging_bucket", len(zipFile), directUploadMaxSize)
+	}
+
+	sum := sha256.Sum256(zipFile)
+	key := fmt.Sprintf("%s/%s.zip", name, hex.EncodeToString(sum[:]))
+
+	_, err = conn.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Body:   bytes.NewReader(zipFile),
+		Bucket: aws.String(stagingBucket),
+		Key:    aws.String(key),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("uploading deployment package to S3 Bucket (%s): %w", stagingBucket, err)
+	}
+
+	return &sourceDirPackage{S3Bucket: stagingBucket, S3Key: key}, nil
+}
+
+// deleteSourceDirPackage deletes the staged S3 object, if any, of the specified deployment package.
+// Lambda copies the package when it creates a function or layer version or updates a function's code,
+// so the staged object is no longer needed once that request has completed.
+func deleteSourceDirPackage(ctx context.Context, conn *s3.S3, pkg *sourceDirPackage) {
+	if pkg == nil || pkg.S3Bucket == "" {
+		return
+	}
+
+	log.Printf("[DEBUG] Deleting staged deployment package: s3://%s/%s", pkg.S3Bucket, pkg.S3Key)
+	_, err := conn.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(pkg.S3Bucket),
+		Key:    aws.String(pkg.S3Key),
+	})
+
+	if err != nil {
+		log.Printf("[WARN] deleting staged deployment package (s3://%s/%s): %s", pkg.S3Bucket, pkg.S3Key, err)
+	}
+}
+
+// zipDirectory returns a ZIP archive of the regular files in the specified directory.
+// The archive only depends on file paths, contents and whether or not files are executable.
+func zipDirectory(dir string) ([]byte, error) {
+	dir, err := homedir.Expand(dir)
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf := new(bytes.Buffer)
+	w := zip.NewWriter(buf)
+
+	// WalkDir visits entries in lexical order.
+	err = filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		info, err := os.Stat(path)
+
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			return fmt.Errorf("%s: symbolic links to directories are not supported", path)
+		}
+
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		name, err := filepath.Rel(dir, path)
+
+		if err != nil {
+			return err
+		}
+
+		header := &zip.FileHeader{
+			Name:     filepath.ToSlash(name),
+			Method:   zip.Deflate,
+			Modified: zipEntryModified,
+		}
+
+		if info.Mode().Perm()&0111 != 0 {
+			header.SetMode(0755)
+		} else {
+			header.SetMode(0644)
+		}
+
+		f, err := w.CreateHeader(header)
+
+		if err != nil {
+			return err
+		}
+
+		contents, err := os.ReadFile(path)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = f.Write(contents)
+
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// codeSHA256 returns the base64-encoded SHA-256 hash of a deployment package, as reported by Lambda.
+func codeSHA256(zipFile []byte) string {
+	sum := sha256.Sum256(zipFile)
+
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+// setSourceDirCodeHash sets source_code_hash from the deployment package built from source_dir.
+func setSourceDirCodeHash(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	v, ok := d.GetOk("source_dir")
+
+	if !ok || !d.NewValueKnown("source_dir") {
+		return nil
+	}
+
+	zipFile, err := zipDirectory(v.(string))
+
+	if err != nil {
+		return fmt.Errorf("packaging source_dir (%s): %w", v, err)
+	}
+
+	if hash := codeSHA256(zipFile); d.Get("source_code_hash").(string) != hash {
+		return d.SetNew("source_code_hash", hash)
+	}
+
+	return nil
+}
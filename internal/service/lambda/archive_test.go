@@ -0,0 +1,99 @@
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestZipDirectory(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	for name, mode := range map[string]os.FileMode{
+		"index.js":        0600,
+		"lib/greeting.js": 0644,
+		"bin/bootstrap":   0700,
+	} {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(path, []byte(name), mode); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	zipFile1, err := zipDirectory(dir)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Changing modification times must not change the archive.
+	modified := time.Now().Add(-24 * time.Hour)
+	if err := os.Chtimes(filepath.Join(dir, "index.js"), modified, modified); err != nil {
+		t.Fatal(err)
+	}
+
+	zipFile2, err := zipDirectory(dir)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !bytes.Equal(zipFile1, zipFile2) {
+		t.Errorf("archives differ")
+	}
+
+	if got, want := codeSHA256(zipFile1), codeSHA256(zipFile2); got != want {
+		t.Errorf("got hash %s, expected %s", got, want)
+	}
+
+	r, err := zip.NewReader(bytes.NewReader(zipFile1), int64(len(zipFile1)))
+
+	if err != nil {
+		t.Fatalf("reading archive: %s", err)
+	}
+
+	expected := []struct {
+		name string
+		mode os.FileMode
+	}{
+		{"bin/bootstrap", 0755},
+		{"index.js", 0644},
+		{"lib/greeting.js", 0644},
+	}
+
+	if got, want := len(r.File), len(expected); got != want {
+		t.Fatalf("got %d entries, expected %d", got, want)
+	}
+
+	for i, f := range r.File {
+		if got, want := f.Name, expected[i].name; got != want {
+			t.Errorf("entry %d: got name %s, expected %s", i, got, want)
+		}
+
+		if got, want := f.Mode().Perm(), expected[i].mode; got != want {
+			t.Errorf("entry %s: got mode %s, expected %s", f.Name, got, want)
+		}
+
+		if got, want := f.Modified.UTC(), zipEntryModified; !got.Equal(want) {
+			t.Errorf("entry %s: got modified %s, expected %s", f.Name, got, want)
+		}
+	}
+}
+
+func TestZipDirectory_notFound(t *testing.T) {
+	t.Parallel()
+
+	if _, err := zipDirectory(filepath.Join(t.TempDir(), "missing")); err == nil {
+		t.Fatal("expected error")
+	}
+}
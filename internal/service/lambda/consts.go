@@ -18,6 +18,12 @@ const (
 	propagationTimeout = 5 * time.Minute
 )
 
+const (
+	errCodeAccessDeniedException         = "AccessDeniedException"
+	errCodeUnknownOperationException     = "UnknownOperationException"
+	errCodeUnsupportedOperationException = "UnsupportedOperationException"
+)
+
 const (
 	invocationActionCreate = "create"
 	invocationActionDelete = "delete"
@@ -15,6 +15,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -25,6 +27,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tflogs "github.com/hashicorp/terraform-provider-aws/internal/service/logs"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -166,7 +169,7 @@ func ResourceFunction() *schema.Resource {
 			"filename": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket"},
+				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket", "source_dir"},
 			},
 			"function_name": {
 				Type:         schema.TypeString,
@@ -205,7 +208,7 @@ func ResourceFunction() *schema.Resource {
 			"image_uri": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket"},
+				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket", "source_dir"},
 			},
 			"invoke_arn": {
 				Type:     schema.TypeString,
@@ -229,6 +232,39 @@ func ResourceFunction() *schema.Resource {
 					ValidateFunc: verify.ValidARN,
 				},
 			},
+			"logging_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"application_log_level": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[types.ApplicationLogLevel](),
+						},
+						"log_format": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[types.LogFormat](),
+						},
+						"log_group": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringLenBetween(1, 512),
+						},
+						"system_log_level": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[types.SystemLogLevel](),
+						},
+					},
+				},
+			},
 			"memory_size": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -255,6 +291,12 @@ func ResourceFunction() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"recursive_loop": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          types.RecursiveLoopTerminate,
+				ValidateDiagFunc: enum.Validate[types.RecursiveLoop](),
+			},
 			"replace_security_groups_on_destroy": {
 				Deprecated: "AWS no longer supports this operation. This attribute now has " +
 					"no effect and will be removed in a future major version.",
@@ -288,7 +330,7 @@ func ResourceFunction() *schema.Resource {
 			"s3_bucket": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket"},
+				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket", "source_dir"},
 				RequiredWith: []string{"s3_key"},
 			},
 			"s3_key": {
@@ -299,7 +341,7 @@ func ResourceFunction() *schema.Resource {
 			"s3_object_version": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"filename", "image_uri"},
+				ConflictsWith: []string{"filename", "image_uri", "source_dir"},
 			},
 			"signing_job_arn": {
 				Type:     schema.TypeString,
@@ -341,6 +383,17 @@ func ResourceFunction() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"source_dir": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ExactlyOneOf:  []string{"filename", "image_uri", "s3_bucket", "source_dir"},
+				ConflictsWith: []string{"source_code_hash"},
+			},
+			"source_dir_staging_bucket": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"source_dir"},
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"timeout": {
@@ -413,6 +466,8 @@ func ResourceFunction() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			checkHandlerRuntimeForZipFunction,
+			checkLogLevelsForJSONLogFormat,
+			setSourceDirCodeHash,
 			updateComputedAttributesOnPublish,
 			verify.SetTagsDiff,
 		),
@@ -456,6 +511,25 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 		input.Code.ZipFile = zipFile
 	} else if v, ok := d.GetOk("image_uri"); ok {
 		input.Code.ImageUri = aws.String(v.(string))
+	} else if v, ok := d.GetOk("source_dir"); ok {
+		conns.GlobalMutexKV.Lock(mutexKey)
+		defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+		s3Conn := meta.(*conns.AWSClient).S3Conn(ctx)
+		pkg, err := packageSourceDir(ctx, s3Conn, v.(string), d.Get("source_dir_staging_bucket").(string), functionName)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "packaging source_dir (%s): %s", v, err)
+		}
+
+		defer deleteSourceDirPackage(ctx, s3Conn, pkg)
+
+		if pkg.S3Bucket != "" {
+			input.Code.S3Bucket = aws.String(pkg.S3Bucket)
+			input.Code.S3Key = aws.String(pkg.S3Key)
+		} else {
+			input.Code.ZipFile = pkg.ZipFile
+		}
 	} else {
 		input.Code.S3Bucket = aws.String(d.Get("s3_bucket").(string))
 		input.Code.S3Key = aws.String(d.Get("s3_key").(string))
@@ -517,6 +591,10 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 		input.Layers = flex.ExpandStringValueList(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("logging_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.LoggingConfig = expandLoggingConfig(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("snap_start"); ok {
 		input.SnapStart = expandSnapStart(v.([]interface{}))
 	}
@@ -568,6 +646,19 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	if v := types.RecursiveLoop(d.Get("recursive_loop").(string)); v != types.RecursiveLoopTerminate {
+		_, err := conn.PutFunctionRecursionConfig(ctx, &lambda.PutFunctionRecursionConfigInput{
+			FunctionName:  aws.String(d.Id()),
+			RecursiveLoop: v,
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting Lambda Function (%s) recursion config: %s", d.Id(), err)
+		}
+	}
+
+	diags = append(diags, checkFunctionLogGroup(ctx, d, meta)...)
+
 	return append(diags, resourceFunctionRead(ctx, d, meta)...)
 }
 
@@ -635,6 +726,9 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta inte
 	if err := d.Set("layers", flattenLayers(function.Layers)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting layers: %s", err)
 	}
+	if err := d.Set("logging_config", flattenLoggingConfig(function.LoggingConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting logging_config: %s", err)
+	}
 	d.Set("memory_size", function.MemorySize)
 	d.Set("package_type", function.PackageType)
 	if output.Concurrency != nil {
@@ -669,6 +763,21 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return sdkdiag.AppendErrorf(diags, "setting vpc_config: %s", err)
 	}
 
+	recursionConfig, err := conn.GetFunctionRecursionConfig(ctx, &lambda.GetFunctionRecursionConfigInput{
+		FunctionName: aws.String(d.Id()),
+	})
+
+	switch {
+	case isFunctionRecursionConfigUnavailableError(err):
+		// Missing lambda:GetFunctionRecursionConfig permission or API not available in this partition or Region.
+		// Leave any prior state value in place rather than assuming the API default.
+		diags = sdkdiag.AppendWarningf(diags, "reading Lambda Function (%s) recursion config: %s", d.Id(), err)
+	case err != nil:
+		return sdkdiag.AppendErrorf(diags, "reading Lambda Function (%s) recursion config: %s", d.Id(), err)
+	default:
+		d.Set("recursive_loop", recursionConfig.RecursiveLoop)
+	}
+
 	if hasQualifier {
 		d.Set("qualified_arn", functionARN)
 		d.Set("qualified_invoke_arn", functionInvokeARN(functionARN, meta))
@@ -818,6 +927,12 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			input.Layers = flex.ExpandStringValueList(d.Get("layers").([]interface{}))
 		}
 
+		if d.HasChange("logging_config") {
+			if v, ok := d.GetOk("logging_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.LoggingConfig = expandLoggingConfig(v.([]interface{}))
+			}
+		}
+
 		if d.HasChange("memory_size") {
 			input.MemorySize = aws.Int32(int32(d.Get("memory_size").(int)))
 		}
@@ -872,6 +987,10 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		if _, err := waitFunctionUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for Lambda Function (%s) configuration update: %s", d.Id(), err)
 		}
+
+		if d.HasChange("logging_config") {
+			diags = append(diags, checkFunctionLogGroup(ctx, d, meta)...)
+		}
 	}
 
 	codeUpdate := needsFunctionCodeUpdate(d)
@@ -905,6 +1024,25 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			input.ZipFile = zipFile
 		} else if v, ok := d.GetOk("image_uri"); ok {
 			input.ImageUri = aws.String(v.(string))
+		} else if v, ok := d.GetOk("source_dir"); ok {
+			conns.GlobalMutexKV.Lock(mutexKey)
+			defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+			s3Conn := meta.(*conns.AWSClient).S3Conn(ctx)
+			pkg, err := packageSourceDir(ctx, s3Conn, v.(string), d.Get("source_dir_staging_bucket").(string), d.Id())
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "packaging source_dir (%s): %s", v, err)
+			}
+
+			defer deleteSourceDirPackage(ctx, s3Conn, pkg)
+
+			if pkg.S3Bucket != "" {
+				input.S3Bucket = aws.String(pkg.S3Bucket)
+				input.S3Key = aws.String(pkg.S3Key)
+			} else {
+				input.ZipFile = pkg.ZipFile
+			}
 		} else {
 			input.S3Bucket = aws.String(d.Get("s3_bucket").(string))
 			input.S3Key = aws.String(d.Get("s3_key").(string))
@@ -954,6 +1092,17 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	if d.HasChange("recursive_loop") {
+		_, err := conn.PutFunctionRecursionConfig(ctx, &lambda.PutFunctionRecursionConfigInput{
+			FunctionName:  aws.String(d.Id()),
+			RecursiveLoop: types.RecursiveLoop(d.Get("recursive_loop").(string)),
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting Lambda Function (%s) recursion config: %s", d.Id(), err)
+		}
+	}
+
 	if d.Get("publish").(bool) && (codeUpdate || configUpdate || d.HasChange("publish")) {
 		input := &lambda.PublishVersionInput{
 			FunctionName: aws.String(d.Id()),
@@ -1047,6 +1196,10 @@ func findFunction(ctx context.Context, conn *lambda.Client, input *lambda.GetFun
 	return output, nil
 }
 
+func isFunctionRecursionConfigUnavailableError(err error) bool {
+	return tfawserr.ErrCodeEquals(err, errCodeAccessDeniedException, errCodeUnknownOperationException, errCodeUnsupportedOperationException)
+}
+
 func findLatestFunctionVersionByName(ctx context.Context, conn *lambda.Client, name string) (*types.FunctionConfiguration, error) {
 	input := &lambda.ListVersionsByFunctionInput{
 		FunctionName: aws.String(name),
@@ -1207,6 +1360,58 @@ func checkHandlerRuntimeForZipFunction(_ context.Context, d *schema.ResourceDiff
 	return nil
 }
 
+func checkLogLevelsForJSONLogFormat(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Log levels are Optional+Computed so use the raw configuration rather than any prior state values.
+	loggingConfigs := d.GetRawConfig().GetAttr("logging_config")
+	if !loggingConfigs.IsKnown() || loggingConfigs.IsNull() || loggingConfigs.LengthInt() == 0 {
+		return nil
+	}
+
+	loggingConfig := loggingConfigs.Index(cty.NumberIntVal(0))
+	if !loggingConfig.IsKnown() || loggingConfig.IsNull() {
+		return nil
+	}
+
+	logFormat := loggingConfig.GetAttr("log_format")
+	if !logFormat.IsKnown() || logFormat.IsNull() || logFormat.AsString() == string(types.LogFormatJson) {
+		return nil
+	}
+
+	for _, key := range []string{"application_log_level", "system_log_level"} {
+		if v := loggingConfig.GetAttr(key); !v.IsKnown() || !v.IsNull() {
+			return fmt.Errorf("logging_config.0.%s can only be set when log_format is %s", key, types.LogFormatJson)
+		}
+	}
+
+	return nil
+}
+
+// checkFunctionLogGroup warns if the function's configured CloudWatch Logs log group does not exist.
+// Lambda creates a missing log group on first invocation and that log group is not managed by Terraform.
+func checkFunctionLogGroup(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	logGroupName, ok := d.GetOk("logging_config.0.log_group")
+
+	if !ok {
+		return diags
+	}
+
+	_, err := tflogs.FindLogGroupByName(ctx, meta.(*conns.AWSClient).LogsConn(ctx), logGroupName.(string))
+
+	if tfresource.NotFound(err) {
+		return sdkdiag.AppendWarningf(diags, "Lambda Function (%s) sends logs to CloudWatch Logs Log Group (%s), which does not exist. "+
+			"Lambda will create it on first invocation, without retention settings, and it will not be managed by Terraform. "+
+			"Use an aws_cloudwatch_log_group resource to manage the log group.", d.Id(), logGroupName)
+	}
+
+	if err != nil {
+		log.Printf("[WARN] Unable to read CloudWatch Logs Log Group (%s), skipping check: %s", logGroupName, err)
+	}
+
+	return diags
+}
+
 func updateComputedAttributesOnPublish(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	configChanged := needsFunctionConfigUpdate(d)
 	codeChanged := needsFunctionCodeUpdate(d)
@@ -1231,6 +1436,7 @@ func needsFunctionCodeUpdate(d verify.ResourceDiffer) bool {
 		d.HasChange("s3_key") ||
 		d.HasChange("s3_object_version") ||
 		d.HasChange("image_uri") ||
+		d.HasChange("source_dir") ||
 		d.HasChange("architectures")
 }
 
@@ -1244,6 +1450,7 @@ func needsFunctionConfigUpdate(d verify.ResourceDiffer) bool {
 		d.HasChange("timeout") ||
 		d.HasChange("kms_key_arn") ||
 		d.HasChange("layers") ||
+		d.HasChange("logging_config") ||
 		d.HasChange("dead_letter_config") ||
 		d.HasChange("snap_start") ||
 		d.HasChange("tracing_config") ||
@@ -1385,6 +1592,44 @@ func flattenEphemeralStorage(response *types.EphemeralStorage) []map[string]inte
 	return []map[string]interface{}{m}
 }
 
+func expandLoggingConfig(tfList []interface{}) *types.LoggingConfig {
+	loggingConfig := &types.LoggingConfig{}
+	if len(tfList) == 1 && tfList[0] != nil {
+		item := tfList[0].(map[string]interface{})
+		if v, ok := item["log_format"].(string); ok && v != "" {
+			loggingConfig.LogFormat = types.LogFormat(v)
+		}
+		if v, ok := item["log_group"].(string); ok && v != "" {
+			loggingConfig.LogGroup = aws.String(v)
+		}
+		// Log levels are only valid for JSON formatted logs.
+		if loggingConfig.LogFormat == types.LogFormatJson {
+			if v, ok := item["application_log_level"].(string); ok && v != "" {
+				loggingConfig.ApplicationLogLevel = types.ApplicationLogLevel(v)
+			}
+			if v, ok := item["system_log_level"].(string); ok && v != "" {
+				loggingConfig.SystemLogLevel = types.SystemLogLevel(v)
+			}
+		}
+	}
+	return loggingConfig
+}
+
+func flattenLoggingConfig(apiObject *types.LoggingConfig) []map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{
+		"application_log_level": string(apiObject.ApplicationLogLevel),
+		"log_format":            string(apiObject.LogFormat),
+		"log_group":             aws.ToString(apiObject.LogGroup),
+		"system_log_level":      string(apiObject.SystemLogLevel),
+	}
+
+	return []map[string]interface{}{m}
+}
+
 func expandSnapStart(tfList []interface{}) *types.SnapStart {
 	snapStart := &types.SnapStart{ApplyOn: types.SnapStartApplyOnNone}
 	if len(tfList) == 1 && tfList[0] != nil {
@@ -0,0 +1,53 @@
+package lambda
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/smithy-go"
+)
+
+func TestIsFunctionRecursionConfigUnavailableError(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err      error
+		expected bool
+	}{
+		"nil": {
+			err:      nil,
+			expected: false,
+		},
+		"AccessDeniedException": {
+			err:      &smithy.GenericAPIError{Code: errCodeAccessDeniedException},
+			expected: true,
+		},
+		"UnknownOperationException": {
+			err:      &smithy.GenericAPIError{Code: errCodeUnknownOperationException},
+			expected: true,
+		},
+		"UnsupportedOperationException": {
+			err:      &smithy.GenericAPIError{Code: errCodeUnsupportedOperationException},
+			expected: true,
+		},
+		"ServiceException": {
+			err:      &smithy.GenericAPIError{Code: "ServiceException"},
+			expected: false,
+		},
+		"non-API error": {
+			err:      errors.New("test"),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := isFunctionRecursionConfigUnavailableError(testCase.err), testCase.expected; got != want {
+				t.Errorf("isFunctionRecursionConfigUnavailableError(%v) = %t, want %t", testCase.err, got, want)
+			}
+		})
+	}
+}
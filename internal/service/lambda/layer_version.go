@@ -32,6 +32,8 @@ func ResourceLayerVersion() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: setSourceDirCodeHash,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -71,7 +73,7 @@ func ResourceLayerVersion() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"s3_bucket", "s3_key", "s3_object_version"},
+				ConflictsWith: []string{"s3_bucket", "s3_key", "s3_object_version", "source_dir"},
 			},
 			"layer_arn": {
 				Type:     schema.TypeString,
@@ -92,19 +94,19 @@ func ResourceLayerVersion() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"filename"},
+				ConflictsWith: []string{"filename", "source_dir"},
 			},
 			"s3_key": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"filename"},
+				ConflictsWith: []string{"filename", "source_dir"},
 			},
 			"s3_object_version": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"filename"},
+				ConflictsWith: []string{"filename", "source_dir"},
 			},
 			"signing_job_arn": {
 				Type:     schema.TypeString,
@@ -130,6 +132,18 @@ func ResourceLayerVersion() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"source_dir": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"filename", "s3_bucket", "s3_key", "s3_object_version", "source_code_hash"},
+			},
+			"source_dir_staging_bucket": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"source_dir"},
+			},
 			"version": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -147,13 +161,33 @@ func resourceLayerVersionPublish(ctx context.Context, d *schema.ResourceData, me
 	s3Bucket, bucketOk := d.GetOk("s3_bucket")
 	s3Key, keyOk := d.GetOk("s3_key")
 	s3ObjectVersion, versionOk := d.GetOk("s3_object_version")
+	sourceDir, hasSourceDir := d.GetOk("source_dir")
 
-	if !hasFilename && !bucketOk && !keyOk && !versionOk {
-		return sdkdiag.AppendErrorf(diags, "filename or s3_* attributes must be set")
+	if !hasFilename && !hasSourceDir && !bucketOk && !keyOk && !versionOk {
+		return sdkdiag.AppendErrorf(diags, "filename, source_dir or s3_* attributes must be set")
 	}
 
 	var layerContent *lambda.LayerVersionContentInput
-	if hasFilename {
+	if hasSourceDir {
+		conns.GlobalMutexKV.Lock(mutexLayerKey)
+		defer conns.GlobalMutexKV.Unlock(mutexLayerKey)
+		s3Conn := meta.(*conns.AWSClient).S3Conn(ctx)
+		pkg, err := packageSourceDir(ctx, s3Conn, sourceDir.(string), d.Get("source_dir_staging_bucket").(string), layerName)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "packaging source_dir (%s): %s", sourceDir.(string), err)
+		}
+		defer deleteSourceDirPackage(ctx, s3Conn, pkg)
+		if pkg.S3Bucket != "" {
+			layerContent = &lambda.LayerVersionContentInput{
+				S3Bucket: aws.String(pkg.S3Bucket),
+				S3Key:    aws.String(pkg.S3Key),
+			}
+		} else {
+			layerContent = &lambda.LayerVersionContentInput{
+				ZipFile: pkg.ZipFile,
+			}
+		}
+	} else if hasFilename {
 		conns.GlobalMutexKV.Lock(mutexLayerKey)
 		defer conns.GlobalMutexKV.Unlock(mutexLayerKey)
 		file, err := readFileContents(filename.(string))
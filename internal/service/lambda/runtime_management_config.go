@@ -0,0 +1,230 @@
+package lambda
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_lambda_runtime_management_config", name="Runtime Management Config")
+func ResourceRuntimeManagementConfig() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRuntimeManagementConfigPut,
+		ReadWithoutTimeout:   resourceRuntimeManagementConfigRead,
+		UpdateWithoutTimeout: resourceRuntimeManagementConfigPut,
+		DeleteWithoutTimeout: resourceRuntimeManagementConfigDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: checkRuntimeVersionARNForManualUpdate,
+
+		Schema: map[string]*schema.Schema{
+			"function_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"function_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validFunctionName(),
+			},
+			"qualifier": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"runtime_version_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"update_runtime_on": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          types.UpdateRuntimeOnAuto,
+				ValidateDiagFunc: enum.Validate[types.UpdateRuntimeOn](),
+			},
+		},
+	}
+}
+
+func resourceRuntimeManagementConfigPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LambdaClient(ctx)
+
+	name := d.Get("function_name").(string)
+	qualifier := d.Get("qualifier").(string)
+	id := RuntimeManagementConfigCreateResourceID(name, qualifier)
+	input := &lambda.PutRuntimeManagementConfigInput{
+		FunctionName:    aws.String(name),
+		UpdateRuntimeOn: types.UpdateRuntimeOn(d.Get("update_runtime_on").(string)),
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	if v, ok := d.GetOk("runtime_version_arn"); ok {
+		input.RuntimeVersionArn = aws.String(v.(string))
+	}
+
+	_, err := conn.PutRuntimeManagementConfig(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("putting Lambda Runtime Management Config (%s): %s", id, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(id)
+	}
+
+	return resourceRuntimeManagementConfigRead(ctx, d, meta)
+}
+
+func resourceRuntimeManagementConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LambdaClient(ctx)
+
+	name, qualifier, err := RuntimeManagementConfigParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindRuntimeManagementConfigByNameAndQualifier(ctx, conn, name, qualifier)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lambda Runtime Management Config %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Lambda Runtime Management Config (%s): %s", d.Id(), err)
+	}
+
+	d.Set("function_arn", output.FunctionArn)
+	d.Set("function_name", name)
+	d.Set("qualifier", qualifier)
+	d.Set("runtime_version_arn", output.RuntimeVersionArn)
+	d.Set("update_runtime_on", output.UpdateRuntimeOn)
+
+	return nil
+}
+
+func resourceRuntimeManagementConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LambdaClient(ctx)
+
+	name, qualifier, err := RuntimeManagementConfigParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Removing the configuration restores automatic runtime updates.
+	input := &lambda.PutRuntimeManagementConfigInput{
+		FunctionName:    aws.String(name),
+		UpdateRuntimeOn: types.UpdateRuntimeOnAuto,
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	log.Printf("[INFO] Deleting Lambda Runtime Management Config: %s", d.Id())
+	_, err = conn.PutRuntimeManagementConfig(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Lambda Runtime Management Config (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func checkRuntimeVersionARNForManualUpdate(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	updateRuntimeOn := d.Get("update_runtime_on").(string)
+	_, runtimeVersionARNOk := d.GetOk("runtime_version_arn")
+
+	if updateRuntimeOn == string(types.UpdateRuntimeOnManual) && !runtimeVersionARNOk && d.NewValueKnown("runtime_version_arn") {
+		return fmt.Errorf("runtime_version_arn must be set when update_runtime_on is %s", types.UpdateRuntimeOnManual)
+	}
+
+	if updateRuntimeOn != string(types.UpdateRuntimeOnManual) && runtimeVersionARNOk {
+		return fmt.Errorf("runtime_version_arn can only be set when update_runtime_on is %s", types.UpdateRuntimeOnManual)
+	}
+
+	return nil
+}
+
+func FindRuntimeManagementConfigByNameAndQualifier(ctx context.Context, conn *lambda.Client, name, qualifier string) (*lambda.GetRuntimeManagementConfigOutput, error) {
+	input := &lambda.GetRuntimeManagementConfigInput{
+		FunctionName: aws.String(name),
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	output, err := conn.GetRuntimeManagementConfig(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+const runtimeManagementConfigResourceIDSeparator = ","
+
+func RuntimeManagementConfigCreateResourceID(functionName, qualifier string) string {
+	if qualifier == "" {
+		return functionName
+	}
+
+	parts := []string{functionName, qualifier}
+	id := strings.Join(parts, runtimeManagementConfigResourceIDSeparator)
+
+	return id
+}
+
+func RuntimeManagementConfigParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, runtimeManagementConfigResourceIDSeparator)
+
+	if len(parts) == 1 && parts[0] != "" {
+		return parts[0], "", nil
+	}
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected FUNCTION-NAME%[2]qQUALIFIER or FUNCTION-NAME", id, runtimeManagementConfigResourceIDSeparator)
+}
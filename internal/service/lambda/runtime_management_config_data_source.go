@@ -0,0 +1,87 @@
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// @SDKDataSource("aws_lambda_runtime_management_config")
+func DataSourceRuntimeManagementConfig() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceRuntimeManagementConfigRead,
+
+		Schema: map[string]*schema.Schema{
+			"current_runtime_version_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"function_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"function_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validFunctionName(),
+			},
+			"qualifier": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"runtime_version_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"update_runtime_on": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceRuntimeManagementConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LambdaClient(ctx)
+
+	name := d.Get("function_name").(string)
+	qualifier := d.Get("qualifier").(string)
+	id := RuntimeManagementConfigCreateResourceID(name, qualifier)
+
+	output, err := FindRuntimeManagementConfigByNameAndQualifier(ctx, conn, name, qualifier)
+
+	if err != nil {
+		return diag.Errorf("reading Lambda Runtime Management Config (%s): %s", id, err)
+	}
+
+	// The runtime version in use is only returned by the management configuration if updates are manual.
+	input := &lambda.GetFunctionInput{
+		FunctionName: aws.String(name),
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	function, err := findFunction(ctx, conn, input)
+
+	if err != nil {
+		return diag.Errorf("reading Lambda Function (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+	if v := function.Configuration.RuntimeVersionConfig; v != nil {
+		d.Set("current_runtime_version_arn", v.RuntimeVersionArn)
+	} else {
+		d.Set("current_runtime_version_arn", nil)
+	}
+	d.Set("function_arn", output.FunctionArn)
+	d.Set("runtime_version_arn", output.RuntimeVersionArn)
+	d.Set("update_runtime_on", output.UpdateRuntimeOn)
+
+	return nil
+}
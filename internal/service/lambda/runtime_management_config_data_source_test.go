@@ -0,0 +1,45 @@
+package lambda_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLambdaRuntimeManagementConfigDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_lambda_runtime_management_config.test"
+	resourceName := "aws_lambda_runtime_management_config.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuntimeManagementConfigDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, "current_runtime_version_arn", regexp.MustCompile(`^arn:.+:lambda:.+::runtime:.+$`)),
+					resource.TestCheckResourceAttrPair(dataSourceName, "function_arn", resourceName, "function_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "function_name", resourceName, "function_name"),
+					resource.TestCheckResourceAttr(dataSourceName, "runtime_version_arn", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "update_runtime_on", string(types.UpdateRuntimeOnFunctionUpdate)),
+				),
+			},
+		},
+	})
+}
+
+func testAccRuntimeManagementConfigDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccRuntimeManagementConfigConfig_basic(rName, string(types.UpdateRuntimeOnFunctionUpdate)), `
+data "aws_lambda_runtime_management_config" "test" {
+  function_name = aws_lambda_runtime_management_config.test.function_name
+}
+`)
+}
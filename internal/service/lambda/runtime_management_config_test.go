@@ -0,0 +1,212 @@
+package lambda_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflambda "github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLambdaRuntimeManagementConfig_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf lambda.GetRuntimeManagementConfigOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_runtime_management_config.test"
+	functionResourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuntimeManagementConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuntimeManagementConfigConfig_basic(rName, string(types.UpdateRuntimeOnFunctionUpdate)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRuntimeManagementConfigExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttrPair(resourceName, "function_arn", functionResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "function_name", functionResourceName, "function_name"),
+					resource.TestCheckResourceAttr(resourceName, "qualifier", ""),
+					resource.TestCheckResourceAttr(resourceName, "runtime_version_arn", ""),
+					resource.TestCheckResourceAttr(resourceName, "update_runtime_on", string(types.UpdateRuntimeOnFunctionUpdate)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccLambdaRuntimeManagementConfig_manual(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf lambda.GetRuntimeManagementConfigOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_runtime_management_config.test"
+	dataSourceName := "data.aws_lambda_runtime_management_config.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuntimeManagementConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuntimeManagementConfigConfig_manual(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRuntimeManagementConfigExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttrPair(resourceName, "runtime_version_arn", dataSourceName, "current_runtime_version_arn"),
+					resource.TestCheckResourceAttr(resourceName, "update_runtime_on", string(types.UpdateRuntimeOnManual)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRuntimeManagementConfigConfig_basic(rName, string(types.UpdateRuntimeOnAuto)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRuntimeManagementConfigExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "runtime_version_arn", ""),
+					resource.TestCheckResourceAttr(resourceName, "update_runtime_on", string(types.UpdateRuntimeOnAuto)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaRuntimeManagementConfig_manualWithoutRuntimeVersionARN(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuntimeManagementConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRuntimeManagementConfigConfig_basic(rName, string(types.UpdateRuntimeOnManual)),
+				ExpectError: regexp.MustCompile(`runtime_version_arn must be set when update_runtime_on is Manual`),
+			},
+		},
+	})
+}
+
+func testAccCheckRuntimeManagementConfigExists(ctx context.Context, n string, v *lambda.GetRuntimeManagementConfigOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lambda Runtime Management Config ID is set")
+		}
+
+		name, qualifier, err := tflambda.RuntimeManagementConfigParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaClient(ctx)
+
+		output, err := tflambda.FindRuntimeManagementConfigByNameAndQualifier(ctx, conn, name, qualifier)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckRuntimeManagementConfigDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_lambda_runtime_management_config" {
+				continue
+			}
+
+			name, qualifier, err := tflambda.RuntimeManagementConfigParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			output, err := tflambda.FindRuntimeManagementConfigByNameAndQualifier(ctx, conn, name, qualifier)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if output.UpdateRuntimeOn == types.UpdateRuntimeOnAuto {
+				continue
+			}
+
+			return fmt.Errorf("Lambda Runtime Management Config %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccRuntimeManagementConfigConfig_base(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLambdaBase(rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "exports.example"
+  runtime       = "nodejs18.x"
+}
+`, rName))
+}
+
+func testAccRuntimeManagementConfigConfig_basic(rName, updateRuntimeOn string) string {
+	return acctest.ConfigCompose(testAccRuntimeManagementConfigConfig_base(rName), fmt.Sprintf(`
+resource "aws_lambda_runtime_management_config" "test" {
+  function_name     = aws_lambda_function.test.function_name
+  update_runtime_on = %[1]q
+}
+`, updateRuntimeOn))
+}
+
+func testAccRuntimeManagementConfigConfig_manual(rName string) string {
+	return acctest.ConfigCompose(testAccRuntimeManagementConfigConfig_base(rName), `
+data "aws_lambda_runtime_management_config" "test" {
+  function_name = aws_lambda_function.test.function_name
+}
+
+resource "aws_lambda_runtime_management_config" "test" {
+  function_name       = aws_lambda_function.test.function_name
+  runtime_version_arn = data.aws_lambda_runtime_management_config.test.current_runtime_version_arn
+  update_runtime_on   = "Manual"
+}
+`)
+}
@@ -55,6 +55,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceLayerVersion,
 			TypeName: "aws_lambda_layer_version",
 		},
+		{
+			Factory:  DataSourceRuntimeManagementConfig,
+			TypeName: "aws_lambda_runtime_management_config",
+		},
 	}
 }
 
@@ -108,6 +112,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceProvisionedConcurrencyConfig,
 			TypeName: "aws_lambda_provisioned_concurrency_config",
 		},
+		{
+			Factory:  ResourceRuntimeManagementConfig,
+			TypeName: "aws_lambda_runtime_management_config",
+			Name:     "Runtime Management Config",
+		},
 	}
 }
 
@@ -0,0 +1,5 @@
+var greeting = require('./lib/greeting')
+
+exports.example = function(event, context) {
+    console.log(greeting.message)
+}
@@ -0,0 +1 @@
+exports.message = "Hello from source_dir"
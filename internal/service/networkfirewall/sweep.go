@@ -42,6 +42,14 @@ func init() {
 			"aws_networkfirewall_firewall_policy",
 		},
 	})
+
+	resource.AddTestSweepers("aws_networkfirewall_tls_inspection_configuration", &resource.Sweeper{
+		Name: "aws_networkfirewall_tls_inspection_configuration",
+		F:    sweepTLSInspectionConfigurations,
+		Dependencies: []string{
+			"aws_networkfirewall_firewall_policy",
+		},
+	})
 }
 
 func sweepFirewallPolicies(region string) error {
@@ -219,3 +227,47 @@ func sweepRuleGroups(region string) error {
 
 	return nil
 }
+
+func sweepTLSInspectionConfigurations(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.NetworkFirewallConn(ctx)
+	input := &networkfirewall.ListTLSInspectionConfigurationsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListTLSInspectionConfigurationsPagesWithContext(ctx, input, func(page *networkfirewall.ListTLSInspectionConfigurationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.TLSInspectionConfigurations {
+			r := ResourceTLSInspectionConfiguration()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Arn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping NetworkFirewall TLS Inspection Configuration sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing NetworkFirewall TLS Inspection Configurations (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping NetworkFirewall TLS Inspection Configurations (%s): %w", region, err)
+	}
+
+	return nil
+}
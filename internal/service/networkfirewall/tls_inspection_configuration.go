@@ -0,0 +1,611 @@
+package networkfirewall
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkfirewall"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_networkfirewall_tls_inspection_configuration", name="TLS Inspection Configuration")
+// @Tags(identifierAttribute="id")
+func ResourceTLSInspectionConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTLSInspectionConfigurationCreate,
+		ReadWithoutTimeout:   resourceTLSInspectionConfigurationRead,
+		UpdateWithoutTimeout: resourceTLSInspectionConfigurationUpdate,
+		DeleteWithoutTimeout: resourceTLSInspectionConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"certificate_authority": tlsCertificateDataSchema(),
+			"certificates":          tlsCertificateDataSchema(),
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 512),
+			},
+			"encryption_configuration": encryptionConfigurationSchema(),
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9-]{1,128}$`), "Must have 1-128 valid characters: a-z, A-Z, 0-9 and -(hyphen)"),
+			},
+			"number_of_associations": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"tls_inspection_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"server_certificate_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"certificate_authority_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"check_certificate_revocation_status": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"revoked_status_action": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice(networkfirewall.RevocationCheckAction_Values(), false),
+												},
+												"unknown_status_action": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice(networkfirewall.RevocationCheckAction_Values(), false),
+												},
+											},
+										},
+									},
+									"scope": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"destination":      tlsInspectionAddressSchema(),
+												"destination_port": tlsInspectionPortRangeSchema(),
+												"protocols": {
+													Type:     schema.TypeSet,
+													Optional: true,
+													Elem: &schema.Schema{
+														Type:         schema.TypeInt,
+														ValidateFunc: validation.IntBetween(0, 255),
+													},
+												},
+												"source":      tlsInspectionAddressSchema(),
+												"source_port": tlsInspectionPortRangeSchema(),
+											},
+										},
+									},
+									"server_certificate": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"resource_arn": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"tls_inspection_configuration_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"update_token": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func tlsCertificateDataSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"certificate_arn": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"certificate_serial": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"status": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"status_message": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func tlsInspectionAddressSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"address_definition": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: verify.ValidCIDRNetworkAddress,
+				},
+			},
+		},
+	}
+}
+
+func tlsInspectionPortRangeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"from_port": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IsPortNumberOrZero,
+				},
+				"to_port": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IsPortNumberOrZero,
+				},
+			},
+		},
+	}
+}
+
+func resourceTLSInspectionConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).NetworkFirewallConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &networkfirewall.CreateTLSInspectionConfigurationInput{
+		EncryptionConfiguration:        expandEncryptionConfiguration(d.Get("encryption_configuration").([]interface{})),
+		Tags:                           getTagsIn(ctx),
+		TLSInspectionConfiguration:     expandTLSInspectionConfiguration(d.Get("tls_inspection_configuration").([]interface{})),
+		TLSInspectionConfigurationName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateTLSInspectionConfigurationWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating NetworkFirewall TLS Inspection Configuration (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.TLSInspectionConfigurationResponse.TLSInspectionConfigurationArn))
+
+	if _, err := waitTLSInspectionConfigurationCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for NetworkFirewall TLS Inspection Configuration (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceTLSInspectionConfigurationRead(ctx, d, meta)
+}
+
+func resourceTLSInspectionConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).NetworkFirewallConn(ctx)
+
+	output, err := FindTLSInspectionConfigurationByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] NetworkFirewall TLS Inspection Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading NetworkFirewall TLS Inspection Configuration (%s): %s", d.Id(), err)
+	}
+
+	response := output.TLSInspectionConfigurationResponse
+	d.Set("arn", response.TLSInspectionConfigurationArn)
+	if err := d.Set("certificate_authority", flattenTLSCertificateData(response.CertificateAuthority)); err != nil {
+		return diag.Errorf("setting certificate_authority: %s", err)
+	}
+	if err := d.Set("certificates", flattenTLSCertificateDataList(response.Certificates)); err != nil {
+		return diag.Errorf("setting certificates: %s", err)
+	}
+	d.Set("description", response.Description)
+	d.Set("encryption_configuration", flattenEncryptionConfiguration(response.EncryptionConfiguration))
+	d.Set("name", response.TLSInspectionConfigurationName)
+	d.Set("number_of_associations", response.NumberOfAssociations)
+	if err := d.Set("tls_inspection_configuration", flattenTLSInspectionConfiguration(output.TLSInspectionConfiguration)); err != nil {
+		return diag.Errorf("setting tls_inspection_configuration: %s", err)
+	}
+	d.Set("tls_inspection_configuration_id", response.TLSInspectionConfigurationId)
+	d.Set("update_token", output.UpdateToken)
+
+	setTagsOut(ctx, response.Tags)
+
+	return nil
+}
+
+func resourceTLSInspectionConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).NetworkFirewallConn(ctx)
+
+	if d.HasChanges("description", "encryption_configuration", "tls_inspection_configuration") {
+		input := &networkfirewall.UpdateTLSInspectionConfigurationInput{
+			EncryptionConfiguration:       expandEncryptionConfiguration(d.Get("encryption_configuration").([]interface{})),
+			TLSInspectionConfiguration:    expandTLSInspectionConfiguration(d.Get("tls_inspection_configuration").([]interface{})),
+			TLSInspectionConfigurationArn: aws.String(d.Id()),
+			UpdateToken:                   aws.String(d.Get("update_token").(string)),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		_, err := conn.UpdateTLSInspectionConfigurationWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating NetworkFirewall TLS Inspection Configuration (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceTLSInspectionConfigurationRead(ctx, d, meta)
+}
+
+func resourceTLSInspectionConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).NetworkFirewallConn(ctx)
+
+	log.Printf("[DEBUG] Deleting NetworkFirewall TLS Inspection Configuration: %s", d.Id())
+	_, err := tfresource.RetryWhenAWSErrMessageContains(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return conn.DeleteTLSInspectionConfigurationWithContext(ctx, &networkfirewall.DeleteTLSInspectionConfigurationInput{
+			TLSInspectionConfigurationArn: aws.String(d.Id()),
+		})
+	}, networkfirewall.ErrCodeInvalidOperationException, "Unable to delete the object because it is still in use")
+
+	if tfawserr.ErrCodeEquals(err, networkfirewall.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting NetworkFirewall TLS Inspection Configuration (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitTLSInspectionConfigurationDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for NetworkFirewall TLS Inspection Configuration (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindTLSInspectionConfigurationByARN(ctx context.Context, conn *networkfirewall.NetworkFirewall, arn string) (*networkfirewall.DescribeTLSInspectionConfigurationOutput, error) {
+	input := &networkfirewall.DescribeTLSInspectionConfigurationInput{
+		TLSInspectionConfigurationArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeTLSInspectionConfigurationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, networkfirewall.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.TLSInspectionConfigurationResponse == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusTLSInspectionConfiguration(ctx context.Context, conn *networkfirewall.NetworkFirewall, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindTLSInspectionConfigurationByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.TLSInspectionConfigurationResponse.TLSInspectionConfigurationStatus), nil
+	}
+}
+
+func waitTLSInspectionConfigurationCreated(ctx context.Context, conn *networkfirewall.NetworkFirewall, arn string, timeout time.Duration) (*networkfirewall.DescribeTLSInspectionConfigurationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{},
+		Target:  []string{networkfirewall.ResourceStatusActive},
+		Refresh: statusTLSInspectionConfiguration(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkfirewall.DescribeTLSInspectionConfigurationOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitTLSInspectionConfigurationDeleted(ctx context.Context, conn *networkfirewall.NetworkFirewall, arn string, timeout time.Duration) (*networkfirewall.DescribeTLSInspectionConfigurationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{networkfirewall.ResourceStatusDeleting},
+		Target:  []string{},
+		Refresh: statusTLSInspectionConfiguration(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkfirewall.DescribeTLSInspectionConfigurationOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandTLSInspectionConfiguration(l []interface{}) *networkfirewall.TLSInspectionConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return &networkfirewall.TLSInspectionConfiguration{}
+	}
+
+	tfMap := l[0].(map[string]interface{})
+	config := &networkfirewall.TLSInspectionConfiguration{}
+
+	if v, ok := tfMap["server_certificate_configuration"].([]interface{}); ok && len(v) > 0 {
+		config.ServerCertificateConfigurations = expandServerCertificateConfigurations(v)
+	}
+
+	return config
+}
+
+func expandServerCertificateConfigurations(l []interface{}) []*networkfirewall.ServerCertificateConfiguration {
+	configurations := make([]*networkfirewall.ServerCertificateConfiguration, 0, len(l))
+	for _, tfMapRaw := range l {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		configuration := &networkfirewall.ServerCertificateConfiguration{}
+		if v, ok := tfMap["certificate_authority_arn"].(string); ok && v != "" {
+			configuration.CertificateAuthorityArn = aws.String(v)
+		}
+		if v, ok := tfMap["check_certificate_revocation_status"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			configuration.CheckCertificateRevocationStatus = expandCheckCertificateRevocationStatusActions(v[0].(map[string]interface{}))
+		}
+		if v, ok := tfMap["scope"].([]interface{}); ok && len(v) > 0 {
+			configuration.Scopes = expandServerCertificateScopes(v)
+		}
+		if v, ok := tfMap["server_certificate"].([]interface{}); ok && len(v) > 0 {
+			configuration.ServerCertificates = expandServerCertificates(v)
+		}
+
+		configurations = append(configurations, configuration)
+	}
+
+	return configurations
+}
+
+func expandCheckCertificateRevocationStatusActions(tfMap map[string]interface{}) *networkfirewall.CheckCertificateRevocationStatusActions {
+	actions := &networkfirewall.CheckCertificateRevocationStatusActions{}
+
+	if v, ok := tfMap["revoked_status_action"].(string); ok && v != "" {
+		actions.RevokedStatusAction = aws.String(v)
+	}
+	if v, ok := tfMap["unknown_status_action"].(string); ok && v != "" {
+		actions.UnknownStatusAction = aws.String(v)
+	}
+
+	return actions
+}
+
+func expandServerCertificateScopes(l []interface{}) []*networkfirewall.ServerCertificateScope {
+	scopes := make([]*networkfirewall.ServerCertificateScope, 0, len(l))
+	for _, tfMapRaw := range l {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		scope := &networkfirewall.ServerCertificateScope{}
+		if v, ok := tfMap["destination"].(*schema.Set); ok && v.Len() > 0 {
+			scope.Destinations = expandAddresses(v.List())
+		}
+		if v, ok := tfMap["destination_port"].(*schema.Set); ok && v.Len() > 0 {
+			scope.DestinationPorts = expandPortRanges(v.List())
+		}
+		if v, ok := tfMap["protocols"].(*schema.Set); ok && v.Len() > 0 {
+			scope.Protocols = flex.ExpandInt64Set(v)
+		}
+		if v, ok := tfMap["source"].(*schema.Set); ok && v.Len() > 0 {
+			scope.Sources = expandAddresses(v.List())
+		}
+		if v, ok := tfMap["source_port"].(*schema.Set); ok && v.Len() > 0 {
+			scope.SourcePorts = expandPortRanges(v.List())
+		}
+
+		scopes = append(scopes, scope)
+	}
+
+	return scopes
+}
+
+func expandServerCertificates(l []interface{}) []*networkfirewall.ServerCertificate {
+	certificates := make([]*networkfirewall.ServerCertificate, 0, len(l))
+	for _, tfMapRaw := range l {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		certificate := &networkfirewall.ServerCertificate{}
+		if v, ok := tfMap["resource_arn"].(string); ok && v != "" {
+			certificate.ResourceArn = aws.String(v)
+		}
+
+		certificates = append(certificates, certificate)
+	}
+
+	return certificates
+}
+
+func flattenTLSInspectionConfiguration(config *networkfirewall.TLSInspectionConfiguration) []interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"server_certificate_configuration": flattenServerCertificateConfigurations(config.ServerCertificateConfigurations),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenServerCertificateConfigurations(l []*networkfirewall.ServerCertificateConfiguration) []interface{} {
+	configurations := make([]interface{}, 0, len(l))
+	for _, configuration := range l {
+		m := map[string]interface{}{
+			"certificate_authority_arn":           aws.StringValue(configuration.CertificateAuthorityArn),
+			"check_certificate_revocation_status": flattenCheckCertificateRevocationStatusActions(configuration.CheckCertificateRevocationStatus),
+			"scope":                               flattenServerCertificateScopes(configuration.Scopes),
+			"server_certificate":                  flattenServerCertificates(configuration.ServerCertificates),
+		}
+
+		configurations = append(configurations, m)
+	}
+
+	return configurations
+}
+
+func flattenCheckCertificateRevocationStatusActions(actions *networkfirewall.CheckCertificateRevocationStatusActions) []interface{} {
+	if actions == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"revoked_status_action": aws.StringValue(actions.RevokedStatusAction),
+		"unknown_status_action": aws.StringValue(actions.UnknownStatusAction),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenServerCertificateScopes(l []*networkfirewall.ServerCertificateScope) []interface{} {
+	scopes := make([]interface{}, 0, len(l))
+	for _, scope := range l {
+		m := map[string]interface{}{
+			"destination":      flattenAddresses(scope.Destinations),
+			"destination_port": flattenPortRanges(scope.DestinationPorts),
+			"protocols":        flex.FlattenInt64Set(scope.Protocols),
+			"source":           flattenAddresses(scope.Sources),
+			"source_port":      flattenPortRanges(scope.SourcePorts),
+		}
+
+		scopes = append(scopes, m)
+	}
+
+	return scopes
+}
+
+func flattenServerCertificates(l []*networkfirewall.ServerCertificate) []interface{} {
+	certificates := make([]interface{}, 0, len(l))
+	for _, certificate := range l {
+		m := map[string]interface{}{
+			"resource_arn": aws.StringValue(certificate.ResourceArn),
+		}
+
+		certificates = append(certificates, m)
+	}
+
+	return certificates
+}
+
+func flattenTLSCertificateData(data *networkfirewall.TlsCertificateData) []interface{} {
+	if data == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"certificate_arn":    aws.StringValue(data.CertificateArn),
+		"certificate_serial": aws.StringValue(data.CertificateSerial),
+		"status":             aws.StringValue(data.Status),
+		"status_message":     aws.StringValue(data.StatusMessage),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenTLSCertificateDataList(l []*networkfirewall.TlsCertificateData) []interface{} {
+	certificates := make([]interface{}, 0, len(l))
+	for _, data := range l {
+		certificates = append(certificates, flattenTLSCertificateData(data)...)
+	}
+
+	return certificates
+}
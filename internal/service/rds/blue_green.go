@@ -4,11 +4,15 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	rds_sdkv2 "github.com/aws/aws-sdk-go-v2/service/rds"
 	"github.com/aws/aws-sdk-go-v2/service/rds/types"
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -60,10 +64,8 @@ func (o *blueGreenOrchestrator) waitForDeploymentAvailable(ctx context.Context,
 	return dep, nil
 }
 
-func (o *blueGreenOrchestrator) switchover(ctx context.Context, identifier string, timeout time.Duration) (*types.BlueGreenDeployment, error) {
-	input := &rds_sdkv2.SwitchoverBlueGreenDeploymentInput{
-		BlueGreenDeploymentIdentifier: aws.String(identifier),
-	}
+func (o *blueGreenOrchestrator) switchover(ctx context.Context, input *rds_sdkv2.SwitchoverBlueGreenDeploymentInput, timeout time.Duration) (*types.BlueGreenDeployment, error) {
+	identifier := aws.StringValue(input.BlueGreenDeploymentIdentifier)
 	_, err := tfresource.RetryWhen(ctx, 10*time.Minute,
 		func() (interface{}, error) {
 			return o.conn.SwitchoverBlueGreenDeployment(ctx, input)
@@ -156,3 +158,152 @@ func (h *instanceHandler) modifyTarget(ctx context.Context, identifier string, d
 
 	return nil
 }
+
+type clusterHandler struct {
+	conn *rds_sdkv2.Client
+}
+
+func newClusterHandler(conn *rds_sdkv2.Client) *clusterHandler {
+	return &clusterHandler{
+		conn: conn,
+	}
+}
+
+func (h *clusterHandler) createBlueGreenInput(d *schema.ResourceData) *rds_sdkv2.CreateBlueGreenDeploymentInput {
+	input := &rds_sdkv2.CreateBlueGreenDeploymentInput{
+		BlueGreenDeploymentName: aws.String(d.Id()),
+		Source:                  aws.String(d.Get("arn").(string)),
+	}
+
+	if d.HasChange("engine_version") {
+		input.TargetEngineVersion = aws.String(d.Get("engine_version").(string))
+	}
+	if d.HasChange("db_cluster_parameter_group_name") {
+		input.TargetDBClusterParameterGroupName = aws.String(d.Get("db_cluster_parameter_group_name").(string))
+	}
+
+	return input
+}
+
+// deleteBlueGreenDeploymentSource deletes the Blue environment left behind after a switchover.
+// The source can be either a DB instance or a DB cluster, in which case the cluster's instances are deleted first.
+func deleteBlueGreenDeploymentSource(ctx context.Context, conn *rds.RDS, sourceARN string, timeout time.Duration) error {
+	deadline := tfresource.NewDeadline(timeout)
+
+	v, err := arn.Parse(sourceARN)
+	if err != nil {
+		return fmt.Errorf("parsing Blue/Green Deployment source (%s): %w", sourceARN, err)
+	}
+
+	if id, ok := strings.CutPrefix(v.Resource, "db:"); ok {
+		return deleteBlueGreenDeploymentSourceInstance(ctx, conn, id, deadline.Remaining())
+	}
+
+	id, ok := strings.CutPrefix(v.Resource, "cluster:")
+	if !ok {
+		return fmt.Errorf("unsupported Blue/Green Deployment source (%s)", sourceARN)
+	}
+
+	cluster, err := FindDBClusterByID(ctx, conn, id)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading RDS Cluster (%s): %w", id, err)
+	}
+
+	for _, member := range cluster.DBClusterMembers {
+		if err := deleteBlueGreenDeploymentSourceInstance(ctx, conn, aws.StringValue(member.DBInstanceIdentifier), deadline.Remaining()); err != nil {
+			return err
+		}
+	}
+
+	if aws.BoolValue(cluster.DeletionProtection) {
+		_, err := conn.ModifyDBClusterWithContext(ctx, &rds.ModifyDBClusterInput{
+			ApplyImmediately:    aws.Bool(true),
+			DBClusterIdentifier: aws.String(id),
+			DeletionProtection:  aws.Bool(false),
+		})
+
+		if err != nil {
+			return fmt.Errorf("disabling RDS Cluster (%s) deletion protection: %w", id, err)
+		}
+
+		if _, err := waitDBClusterUpdated(ctx, conn, id, deadline.Remaining()); err != nil {
+			return fmt.Errorf("waiting for RDS Cluster (%s) update: %w", id, err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting Blue/Green Deployment source RDS Cluster: %s", id)
+	_, err = conn.DeleteDBClusterWithContext(ctx, &rds.DeleteDBClusterInput{
+		DBClusterIdentifier: aws.String(id),
+		SkipFinalSnapshot:   aws.Bool(true),
+	})
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBClusterNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting RDS Cluster (%s): %w", id, err)
+	}
+
+	if _, err := waitDBClusterDeleted(ctx, conn, id, deadline.Remaining()); err != nil {
+		return fmt.Errorf("waiting for RDS Cluster (%s) delete: %w", id, err)
+	}
+
+	return nil
+}
+
+func deleteBlueGreenDeploymentSourceInstance(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) error {
+	deadline := tfresource.NewDeadline(timeout)
+
+	instance, err := findDBInstanceByIDSDKv1(ctx, conn, id)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading RDS DB Instance (%s): %w", id, err)
+	}
+
+	if aws.BoolValue(instance.DeletionProtection) {
+		_, err := conn.ModifyDBInstanceWithContext(ctx, &rds.ModifyDBInstanceInput{
+			ApplyImmediately:     aws.Bool(true),
+			DBInstanceIdentifier: aws.String(id),
+			DeletionProtection:   aws.Bool(false),
+		})
+
+		if err != nil {
+			return fmt.Errorf("disabling RDS DB Instance (%s) deletion protection: %w", id, err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting Blue/Green Deployment source RDS DB Instance: %s", id)
+	_, err = tfresource.RetryWhenAWSErrMessageContains(ctx, 5*time.Minute,
+		func() (interface{}, error) {
+			return conn.DeleteDBInstanceWithContext(ctx, &rds.DeleteDBInstanceInput{
+				DBInstanceIdentifier: aws.String(id),
+				SkipFinalSnapshot:    aws.Bool(true),
+			})
+		},
+		errCodeInvalidParameterCombination, "disable deletion pro",
+	)
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBInstanceNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting RDS DB Instance (%s): %w", id, err)
+	}
+
+	if _, err := waitDBInstanceDeleted(ctx, conn, id, deadline.Remaining()); err != nil {
+		return fmt.Errorf("waiting for RDS DB Instance (%s) delete: %w", id, err)
+	}
+
+	return nil
+}
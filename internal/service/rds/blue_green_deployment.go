@@ -0,0 +1,274 @@
+package rds
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	rds_sdkv2 "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_rds_blue_green_deployment", name="Blue/Green Deployment")
+// @Tags(identifierAttribute="arn")
+func ResourceBlueGreenDeployment() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceBlueGreenDeploymentCreate,
+		ReadWithoutTimeout:   resourceBlueGreenDeploymentRead,
+		UpdateWithoutTimeout: resourceBlueGreenDeploymentUpdate,
+		DeleteWithoutTimeout: resourceBlueGreenDeploymentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("delete_source_after_switchover", false)
+				d.Set("switchover_timeout", 300)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(120 * time.Minute),
+			Update: schema.DefaultTimeout(120 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"blue_green_deployment_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 60),
+			},
+			"delete_source_after_switchover": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"source": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_details": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"switchover": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"switchover_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      300,
+				ValidateFunc: validation.IntBetween(30, 3600),
+			},
+			"target": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"target_db_cluster_parameter_group_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"target_db_parameter_group_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"target_engine_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			// A completed switchover cannot be reverted.
+			customdiff.ForceNewIfChange("switchover", func(_ context.Context, old, new, meta interface{}) bool {
+				return old.(bool) && !new.(bool)
+			}),
+		),
+	}
+}
+
+func resourceBlueGreenDeploymentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSClient(ctx)
+	orchestrator := newBlueGreenOrchestrator(conn)
+
+	name := d.Get("blue_green_deployment_name").(string)
+	input := &rds_sdkv2.CreateBlueGreenDeploymentInput{
+		BlueGreenDeploymentName: aws.String(name),
+		Source:                  aws.String(d.Get("source").(string)),
+	}
+
+	for _, tag := range getTagsIn(ctx) {
+		input.Tags = append(input.Tags, types.Tag{
+			Key:   tag.Key,
+			Value: tag.Value,
+		})
+	}
+
+	if v, ok := d.GetOk("target_db_cluster_parameter_group_name"); ok {
+		input.TargetDBClusterParameterGroupName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("target_db_parameter_group_name"); ok {
+		input.TargetDBParameterGroupName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("target_engine_version"); ok {
+		input.TargetEngineVersion = aws.String(v.(string))
+	}
+
+	dep, err := orchestrator.createDeployment(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating RDS Blue/Green Deployment (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(dep.BlueGreenDeploymentIdentifier))
+
+	if _, err := orchestrator.waitForDeploymentAvailable(ctx, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for RDS Blue/Green Deployment (%s) create: %s", d.Id(), err)
+	}
+
+	if d.Get("switchover").(bool) {
+		if err := blueGreenDeploymentSwitchover(ctx, d, meta, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating RDS Blue/Green Deployment (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceBlueGreenDeploymentRead(ctx, d, meta)...)
+}
+
+func resourceBlueGreenDeploymentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSClient(ctx)
+
+	dep, err := findBlueGreenDeploymentByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] RDS Blue/Green Deployment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading RDS Blue/Green Deployment (%s): %s", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   "rds",
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  fmt.Sprintf("deployment:%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("blue_green_deployment_name", dep.BlueGreenDeploymentName)
+	d.Set("status", dep.Status)
+	d.Set("status_details", dep.StatusDetails)
+	d.Set("target", dep.Target)
+	// After switchover the source and target ARNs refer to the renamed resources.
+	if aws.StringValue(dep.Status) != "SWITCHOVER_COMPLETED" {
+		d.Set("source", dep.Source)
+	}
+
+	return diags
+}
+
+func resourceBlueGreenDeploymentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if d.HasChange("switchover") && d.Get("switchover").(bool) && d.Get("status").(string) != "SWITCHOVER_COMPLETED" {
+		if err := blueGreenDeploymentSwitchover(ctx, d, meta, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RDS Blue/Green Deployment (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceBlueGreenDeploymentRead(ctx, d, meta)...)
+}
+
+func resourceBlueGreenDeploymentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSClient(ctx)
+
+	input := &rds_sdkv2.DeleteBlueGreenDeploymentInput{
+		BlueGreenDeploymentIdentifier: aws.String(d.Id()),
+	}
+
+	// The Green environment can only be deleted along with the deployment before switchover.
+	if d.Get("status").(string) != "SWITCHOVER_COMPLETED" {
+		input.DeleteTarget = aws.Bool(true)
+	}
+
+	log.Printf("[DEBUG] Deleting RDS Blue/Green Deployment: %s", d.Id())
+	_, err := conn.DeleteBlueGreenDeployment(ctx, input)
+
+	if errs.IsA[*types.BlueGreenDeploymentNotFoundFault](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting RDS Blue/Green Deployment (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitBlueGreenDeploymentDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for RDS Blue/Green Deployment (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// blueGreenDeploymentSwitchover switches over to the Green environment and, if configured, deletes the Blue environment.
+func blueGreenDeploymentSwitchover(ctx context.Context, d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
+	deadline := tfresource.NewDeadline(timeout)
+	orchestrator := newBlueGreenOrchestrator(meta.(*conns.AWSClient).RDSClient(ctx))
+
+	dep, err := orchestrator.switchover(ctx, &rds_sdkv2.SwitchoverBlueGreenDeploymentInput{
+		BlueGreenDeploymentIdentifier: aws.String(d.Id()),
+		SwitchoverTimeout:             aws.Int32(int32(d.Get("switchover_timeout").(int))),
+	}, deadline.Remaining())
+
+	if err != nil {
+		return err
+	}
+
+	if d.Get("delete_source_after_switchover").(bool) {
+		if err := deleteBlueGreenDeploymentSource(ctx, meta.(*conns.AWSClient).RDSConn(ctx), aws.StringValue(dep.Source), deadline.Remaining()); err != nil {
+			return fmt.Errorf("deleting Blue/Green Deployment source: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,219 @@
+package rds_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/aws/aws-sdk-go/service/rds"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfrds "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccRDSBlueGreenDeployment_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v types.BlueGreenDeployment
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_blue_green_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBlueGreenDeploymentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlueGreenDeploymentConfig_basic(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlueGreenDeploymentExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "rds", regexp.MustCompile(`deployment:bgd-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "blue_green_deployment_name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "source", "aws_rds_cluster.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "status", "AVAILABLE"),
+					resource.TestCheckResourceAttr(resourceName, "switchover", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "target"),
+					resource.TestCheckResourceAttr(resourceName, "target_engine_version", "8.0.mysql_aurora.3.05.2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"target_db_cluster_parameter_group_name", "target_engine_version"},
+			},
+		},
+	})
+}
+
+func TestAccRDSBlueGreenDeployment_switchover(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v types.BlueGreenDeployment
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_blue_green_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBlueGreenDeploymentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlueGreenDeploymentConfig_basic(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlueGreenDeploymentExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "status", "AVAILABLE"),
+				),
+			},
+			{
+				Config: testAccBlueGreenDeploymentConfig_basic(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlueGreenDeploymentExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "status", "SWITCHOVER_COMPLETED"),
+					resource.TestCheckResourceAttr(resourceName, "switchover", "true"),
+				),
+				// The switchover updates the source cluster's engine version out of band.
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRDSBlueGreenDeployment_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v types.BlueGreenDeployment
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_blue_green_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBlueGreenDeploymentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlueGreenDeploymentConfig_basic(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlueGreenDeploymentExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfrds.ResourceBlueGreenDeployment(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckBlueGreenDeploymentDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_rds_blue_green_deployment" {
+				continue
+			}
+
+			_, err := tfrds.FindBlueGreenDeploymentByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("RDS Blue/Green Deployment %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBlueGreenDeploymentExists(ctx context.Context, n string, v *types.BlueGreenDeployment) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No RDS Blue/Green Deployment ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSClient(ctx)
+
+		output, err := tfrds.FindBlueGreenDeploymentByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccBlueGreenDeploymentConfig_basic(rName string, switchover bool) string {
+	return fmt.Sprintf(`
+resource "aws_rds_cluster_parameter_group" "test" {
+  name   = %[1]q
+  family = "aurora-mysql8.0"
+
+  parameter {
+    name         = "binlog_format"
+    value        = "ROW"
+    apply_method = "pending-reboot"
+  }
+}
+
+resource "aws_rds_cluster" "test" {
+  cluster_identifier              = %[1]q
+  database_name                   = "test"
+  db_cluster_parameter_group_name = aws_rds_cluster_parameter_group.test.name
+  engine                          = "aurora-mysql"
+  engine_version                  = "8.0.mysql_aurora.3.04.1"
+  master_password                 = "avoid-plaintext-passwords"
+  master_username                 = "tfacctest"
+  skip_final_snapshot             = true
+}
+
+resource "aws_rds_cluster_instance" "test" {
+  identifier         = %[1]q
+  cluster_identifier = aws_rds_cluster.test.id
+  engine             = aws_rds_cluster.test.engine
+  instance_class     = "db.r6g.large"
+}
+
+resource "aws_rds_blue_green_deployment" "test" {
+  blue_green_deployment_name             = %[1]q
+  source                                 = aws_rds_cluster.test.arn
+  target_db_cluster_parameter_group_name = aws_rds_cluster_parameter_group.test.name
+  target_engine_version                  = "8.0.mysql_aurora.3.05.2"
+
+  switchover                     = %[2]t
+  delete_source_after_switchover = true
+
+  depends_on = [aws_rds_cluster_instance.test]
+}
+`, rName, switchover)
+}
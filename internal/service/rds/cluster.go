@@ -2,12 +2,14 @@ package rds
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"regexp"
 	"strings"
 	"time"
 
+	rds_sdkv2 "github.com/aws/aws-sdk-go-v2/service/rds"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/rds"
@@ -25,6 +27,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"golang.org/x/exp/slices"
 )
 
 const (
@@ -91,6 +94,19 @@ func ResourceCluster() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.IntBetween(0, 259200),
 			},
+			"blue_green_update": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
 			"cluster_identifier": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -527,6 +543,21 @@ func ResourceCluster() *schema.Resource {
 				}
 				return nil
 			},
+			func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				if !d.Get("blue_green_update.0.enabled").(bool) {
+					return nil
+				}
+
+				engine := d.Get("engine").(string)
+				if !slices.Contains(clusterValidBlueGreenEngines(), engine) {
+					return fmt.Errorf(`"blue_green_update.enabled" cannot be set when "engine" is %q.`, engine)
+				}
+
+				if d.Get("global_cluster_identifier").(string) != "" {
+					return errors.New(`"blue_green_update.enabled" cannot be set when "global_cluster_identifier" is set.`)
+				}
+				return nil
+			},
 		),
 	}
 }
@@ -1201,14 +1232,28 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
 	conn := meta.(*conns.AWSClient).RDSConn(ctx)
 
-	if d.HasChangesExcept(
+	modifyExcept := []string{
 		"allow_major_version_upgrade",
+		"blue_green_update",
 		"final_snapshot_identifier",
 		"global_cluster_identifier",
 		"iam_roles",
 		"replication_source_identifier",
 		"skip_final_snapshot",
-		"tags", "tags_all") {
+		"tags", "tags_all",
+	}
+
+	blueGreenUpdate := d.Get("blue_green_update.0.enabled").(bool) && d.HasChanges("db_cluster_parameter_group_name", "engine_version")
+	if blueGreenUpdate {
+		if err := clusterBlueGreenUpdate(ctx, d, meta, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): %s", d.Id(), err)
+		}
+
+		// Already applied by the Blue/Green Deployment.
+		modifyExcept = append(modifyExcept, "db_cluster_parameter_group_name", "engine_version")
+	}
+
+	if d.HasChangesExcept(modifyExcept...) {
 		input := &rds.ModifyDBClusterInput{
 			ApplyImmediately:    aws.Bool(d.Get("apply_immediately").(bool)),
 			DBClusterIdentifier: aws.String(d.Id()),
@@ -1238,7 +1283,7 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			input.DBClusterInstanceClass = aws.String(d.Get("db_cluster_instance_class").(string))
 		}
 
-		if d.HasChange("db_cluster_parameter_group_name") {
+		if d.HasChange("db_cluster_parameter_group_name") && !blueGreenUpdate {
 			input.DBClusterParameterGroupName = aws.String(d.Get("db_cluster_parameter_group_name").(string))
 		}
 
@@ -1274,14 +1319,14 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			}
 		}
 
-		if d.HasChange("engine_version") {
+		if d.HasChange("engine_version") && !blueGreenUpdate {
 			input.EngineVersion = aws.String(d.Get("engine_version").(string))
 		}
 
 		// This can happen when updates are deferred (apply_immediately = false), and
 		// multiple applies occur before the maintenance window. In this case,
 		// continue sending the desired engine_version as part of the modify request.
-		if d.Get("engine_version").(string) != d.Get("engine_version_actual").(string) {
+		if d.Get("engine_version").(string) != d.Get("engine_version_actual").(string) && !blueGreenUpdate {
 			input.EngineVersion = aws.String(d.Get("engine_version").(string))
 		}
 
@@ -1431,6 +1476,72 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 	return append(diags, resourceClusterRead(ctx, d, meta)...)
 }
 
+// clusterBlueGreenUpdate applies engine version and cluster parameter group changes by way of a Blue/Green Deployment.
+// The Green environment is created, switched over to and the Blue environment is then deleted.
+func clusterBlueGreenUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, timeout time.Duration) (err error) {
+	conn := meta.(*conns.AWSClient).RDSClient(ctx)
+	deadline := tfresource.NewDeadline(timeout)
+	orchestrator := newBlueGreenOrchestrator(conn)
+	handler := newClusterHandler(conn)
+
+	log.Printf("[DEBUG] Updating RDS Cluster (%s): Creating Blue/Green Deployment", d.Id())
+	dep, err := orchestrator.createDeployment(ctx, handler.createBlueGreenInput(d))
+	if err != nil {
+		return err
+	}
+
+	deploymentIdentifier := aws.StringValue(dep.BlueGreenDeploymentIdentifier)
+	switchedOver := false
+	defer func() {
+		log.Printf("[DEBUG] Updating RDS Cluster (%s): Deleting Blue/Green Deployment", d.Id())
+
+		// Ensure that the Blue/Green Deployment is always cleaned up.
+		input := &rds_sdkv2.DeleteBlueGreenDeploymentInput{
+			BlueGreenDeploymentIdentifier: aws.String(deploymentIdentifier),
+		}
+		if !switchedOver {
+			input.DeleteTarget = aws.Bool(true)
+		}
+		if _, errDelete := conn.DeleteBlueGreenDeployment(ctx, input); errDelete != nil {
+			if err == nil {
+				err = fmt.Errorf("deleting Blue/Green Deployment: %w", errDelete)
+			}
+			return
+		}
+
+		if _, errDelete := waitBlueGreenDeploymentDeleted(ctx, conn, deploymentIdentifier, deadline.Remaining()); errDelete != nil && err == nil {
+			err = fmt.Errorf("deleting Blue/Green Deployment: waiting for completion: %w", errDelete)
+		}
+	}()
+
+	if _, err = orchestrator.waitForDeploymentAvailable(ctx, deploymentIdentifier, deadline.Remaining()); err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating RDS Cluster (%s): Switching over Blue/Green Deployment", d.Id())
+	dep, err = orchestrator.switchover(ctx, &rds_sdkv2.SwitchoverBlueGreenDeploymentInput{
+		BlueGreenDeploymentIdentifier: aws.String(deploymentIdentifier),
+	}, deadline.Remaining())
+	if err != nil {
+		return err
+	}
+	switchedOver = true
+
+	log.Printf("[DEBUG] Updating RDS Cluster (%s): Deleting Blue/Green Deployment source", d.Id())
+	if err = deleteBlueGreenDeploymentSource(ctx, meta.(*conns.AWSClient).RDSConn(ctx), aws.StringValue(dep.Source), deadline.Remaining()); err != nil {
+		return fmt.Errorf("deleting Blue/Green Deployment source: %w", err)
+	}
+
+	return nil
+}
+
+func clusterValidBlueGreenEngines() []string {
+	return []string{
+		ClusterEngineAuroraMySQL,
+		ClusterEngineAuroraPostgreSQL,
+	}
+}
+
 func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
 	conn := meta.(*conns.AWSClient).RDSConn(ctx)
 
@@ -0,0 +1,254 @@
+package rds
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/namevaluesfilters"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKDataSource("aws_rds_cluster_automated_backups")
+func DataSourceClusterAutomatedBackups() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceClusterAutomatedBackupsRead,
+
+		Schema: map[string]*schema.Schema{
+			"automated_backups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allocated_storage": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"availability_zones": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"backup_retention_period": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"cluster_create_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"db_cluster_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"db_cluster_automated_backups_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"db_cluster_identifier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"db_cluster_resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"engine": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"engine_mode": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"engine_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"iam_database_authentication_enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"kms_key_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"restore_window": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"earliest_time": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"latest_time": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"storage_encrypted": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"storage_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"db_cluster_identifier": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"db_cluster_resource_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"filter": namevaluesfilters.Schema(),
+		},
+	}
+}
+
+func dataSourceClusterAutomatedBackupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSConn(ctx)
+
+	input := &rds.DescribeDBClusterAutomatedBackupsInput{}
+
+	if v, ok := d.GetOk("db_cluster_identifier"); ok {
+		input.DBClusterIdentifier = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("db_cluster_resource_id"); ok {
+		input.DbClusterResourceId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("filter"); ok {
+		input.Filters = namevaluesfilters.New(v.(*schema.Set)).RDSFilters()
+	}
+
+	backups, err := findDBClusterAutomatedBackups(ctx, conn, input)
+
+	// No retained automated backups is not an error.
+	if err != nil && !tfresource.NotFound(err) {
+		return sdkdiag.AppendErrorf(diags, "reading RDS DB Cluster Automated Backups: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	if err := d.Set("automated_backups", flattenDBClusterAutomatedBackups(backups)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting automated_backups: %s", err)
+	}
+
+	return diags
+}
+
+func findDBClusterAutomatedBackups(ctx context.Context, conn *rds.RDS, input *rds.DescribeDBClusterAutomatedBackupsInput) ([]*rds.DBClusterAutomatedBackup, error) {
+	var output []*rds.DBClusterAutomatedBackup
+
+	err := conn.DescribeDBClusterAutomatedBackupsPagesWithContext(ctx, input, func(page *rds.DescribeDBClusterAutomatedBackupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DBClusterAutomatedBackups {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBClusterAutomatedBackupNotFoundFault) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func flattenDBClusterAutomatedBackups(apiObjects []*rds.DBClusterAutomatedBackup) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"allocated_storage":                   aws.Int64Value(apiObject.AllocatedStorage),
+			"availability_zones":                  aws.StringValueSlice(apiObject.AvailabilityZones),
+			"backup_retention_period":             aws.Int64Value(apiObject.BackupRetentionPeriod),
+			"db_cluster_arn":                      aws.StringValue(apiObject.DBClusterArn),
+			"db_cluster_automated_backups_arn":    aws.StringValue(apiObject.DBClusterAutomatedBackupsArn),
+			"db_cluster_identifier":               aws.StringValue(apiObject.DBClusterIdentifier),
+			"db_cluster_resource_id":              aws.StringValue(apiObject.DbClusterResourceId),
+			"engine":                              aws.StringValue(apiObject.Engine),
+			"engine_mode":                         aws.StringValue(apiObject.EngineMode),
+			"engine_version":                      aws.StringValue(apiObject.EngineVersion),
+			"iam_database_authentication_enabled": aws.BoolValue(apiObject.IAMDatabaseAuthenticationEnabled),
+			"kms_key_id":                          aws.StringValue(apiObject.KmsKeyId),
+			"port":                                aws.Int64Value(apiObject.Port),
+			"region":                              aws.StringValue(apiObject.Region),
+			"status":                              aws.StringValue(apiObject.Status),
+			"storage_encrypted":                   aws.BoolValue(apiObject.StorageEncrypted),
+			"storage_type":                        aws.StringValue(apiObject.StorageType),
+			"vpc_id":                              aws.StringValue(apiObject.VpcId),
+		}
+
+		if v := apiObject.ClusterCreateTime; v != nil {
+			tfMap["cluster_create_time"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.RestoreWindow; v != nil {
+			tfMap["restore_window"] = []interface{}{flattenRestoreWindow(v)}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenRestoreWindow(apiObject *rds.RestoreWindow) map[string]interface{} {
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.EarliestTime; v != nil {
+		tfMap["earliest_time"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.LatestTime; v != nil {
+		tfMap["latest_time"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	return tfMap
+}
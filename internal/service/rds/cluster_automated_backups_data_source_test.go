@@ -0,0 +1,58 @@
+package rds_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/rds"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccRDSClusterAutomatedBackupsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_rds_cluster_automated_backups.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Automated backups are only retained once a cluster has been deleted.
+				Config: testAccClusterAutomatedBackupsDataSourceConfig_dbClusterIdentifier(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "automated_backups.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "db_cluster_identifier", rName),
+				),
+			},
+			{
+				Config: testAccClusterAutomatedBackupsDataSourceConfig_filter(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "automated_backups.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccClusterAutomatedBackupsDataSourceConfig_dbClusterIdentifier(rName string) string {
+	return fmt.Sprintf(`
+data "aws_rds_cluster_automated_backups" "test" {
+  db_cluster_identifier = %[1]q
+}
+`, rName)
+}
+
+func testAccClusterAutomatedBackupsDataSourceConfig_filter() string {
+	return `
+data "aws_rds_cluster_automated_backups" "test" {
+  filter {
+    name   = "status"
+    values = ["retained"]
+  }
+}
+`
+}
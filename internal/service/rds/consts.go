@@ -97,6 +97,26 @@ const (
 	InstanceStatusUpgrading                                    = "upgrading"
 )
 
+const (
+	CustomEngineVersionStatusAvailable                      = "available"
+	CustomEngineVersionStatusCreating                       = "creating"
+	CustomEngineVersionStatusDeleting                       = "deleting"
+	CustomEngineVersionStatusFailed                         = "failed"
+	CustomEngineVersionStatusInactive                       = "inactive"
+	CustomEngineVersionStatusInactiveExceptRestore          = "inactive-except-restore"
+	CustomEngineVersionStatusIncompatibleImageConfiguration = "incompatible-image-configuration"
+	CustomEngineVersionStatusPendingValidation              = "pending-validation"
+	CustomEngineVersionStatusValidating                     = "validating"
+)
+
+func CustomEngineVersionStatus_Values() []string {
+	return []string{
+		CustomEngineVersionStatusAvailable,
+		CustomEngineVersionStatusInactive,
+		CustomEngineVersionStatusInactiveExceptRestore,
+	}
+}
+
 const (
 	InstanceAutomatedBackupStatusPending     = "pending"
 	InstanceAutomatedBackupStatusReplicating = "replicating"
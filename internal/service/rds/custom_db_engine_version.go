@@ -0,0 +1,429 @@
+package rds
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_rds_custom_db_engine_version", name="Custom DB Engine Version")
+// @Tags(identifierAttribute="arn")
+func ResourceCustomDBEngineVersion() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCustomDBEngineVersionCreate,
+		ReadWithoutTimeout:   resourceCustomDBEngineVersionRead,
+		UpdateWithoutTimeout: resourceCustomDBEngineVersionUpdate,
+		DeleteWithoutTimeout: resourceCustomDBEngineVersionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(240 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"database_installation_files_s3_bucket_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.StringLenBetween(3, 63),
+				ConflictsWith: []string{"source_image_id"},
+				RequiredWith:  []string{"manifest"},
+			},
+			"database_installation_files_s3_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+				RequiredWith: []string{"database_installation_files_s3_bucket_name"},
+			},
+			"db_parameter_group_family": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"engine": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^custom-(oracle|sqlserver)-`), "must be an RDS Custom for Oracle or SQL Server engine"),
+			},
+			"engine_version": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 60),
+			},
+			"image_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"major_engine_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"manifest": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"manifest_computed": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_image_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"database_installation_files_s3_bucket_name"},
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(CustomEngineVersionStatus_Values(), false),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceCustomDBEngineVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSConn(ctx)
+
+	engine, engineVersion := d.Get("engine").(string), d.Get("engine_version").(string)
+	id := customDBEngineVersionCreateResourceID(engine, engineVersion)
+	input := &rds.CreateCustomDBEngineVersionInput{
+		Engine:        aws.String(engine),
+		EngineVersion: aws.String(engineVersion),
+		Tags:          getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("database_installation_files_s3_bucket_name"); ok {
+		input.DatabaseInstallationFilesS3BucketName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("database_installation_files_s3_prefix"); ok {
+		input.DatabaseInstallationFilesS3Prefix = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KMSKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("manifest"); ok {
+		input.Manifest = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("source_image_id"); ok {
+		input.ImageId = aws.String(v.(string))
+	}
+
+	_, err := conn.CreateCustomDBEngineVersionWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating RDS Custom DB Engine Version (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitCustomDBEngineVersionCreated(ctx, conn, engine, engineVersion, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for RDS Custom DB Engine Version (%s) create: %s", d.Id(), err)
+	}
+
+	// A new custom engine version is always created in an active state.
+	if v, ok := d.GetOk("status"); ok && v.(string) != CustomEngineVersionStatusAvailable {
+		if err := modifyCustomDBEngineVersion(ctx, conn, engine, engineVersion, &rds.ModifyCustomDBEngineVersionInput{
+			Status: aws.String(v.(string)),
+		}, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RDS Custom DB Engine Version (%s) status: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceCustomDBEngineVersionRead(ctx, d, meta)...)
+}
+
+func resourceCustomDBEngineVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSConn(ctx)
+
+	engine, engineVersion, err := customDBEngineVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	out, err := FindCustomDBEngineVersionByTwoPartKey(ctx, conn, engine, engineVersion)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] RDS Custom DB Engine Version (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading RDS Custom DB Engine Version (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", out.DBEngineVersionArn)
+	if out.CreateTime != nil {
+		d.Set("create_time", aws.TimeValue(out.CreateTime).Format(time.RFC3339))
+	} else {
+		d.Set("create_time", nil)
+	}
+	d.Set("database_installation_files_s3_bucket_name", out.DatabaseInstallationFilesS3BucketName)
+	d.Set("database_installation_files_s3_prefix", out.DatabaseInstallationFilesS3Prefix)
+	d.Set("db_parameter_group_family", out.DBParameterGroupFamily)
+	d.Set("description", out.DBEngineVersionDescription)
+	d.Set("engine", out.Engine)
+	d.Set("engine_version", out.EngineVersion)
+	if out.Image != nil {
+		d.Set("image_id", out.Image.ImageId)
+	} else {
+		d.Set("image_id", nil)
+	}
+	d.Set("kms_key_id", out.KMSKeyId)
+	d.Set("major_engine_version", out.MajorEngineVersion)
+	d.Set("manifest_computed", out.CustomDBEngineVersionManifest)
+	d.Set("status", out.Status)
+
+	setTagsOut(ctx, out.TagList)
+
+	return diags
+}
+
+func resourceCustomDBEngineVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSConn(ctx)
+
+	if d.HasChanges("description", "status") {
+		engine, engineVersion, err := customDBEngineVersionParseResourceID(d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input := &rds.ModifyCustomDBEngineVersionInput{}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("status") {
+			input.Status = aws.String(d.Get("status").(string))
+		}
+
+		if err := modifyCustomDBEngineVersion(ctx, conn, engine, engineVersion, input, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RDS Custom DB Engine Version (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceCustomDBEngineVersionRead(ctx, d, meta)...)
+}
+
+func resourceCustomDBEngineVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSConn(ctx)
+
+	engine, engineVersion, err := customDBEngineVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting RDS Custom DB Engine Version: %s", d.Id())
+	_, err = conn.DeleteCustomDBEngineVersionWithContext(ctx, &rds.DeleteCustomDBEngineVersionInput{
+		Engine:        aws.String(engine),
+		EngineVersion: aws.String(engineVersion),
+	})
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeCustomDBEngineVersionNotFoundFault) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting RDS Custom DB Engine Version (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitCustomDBEngineVersionDeleted(ctx, conn, engine, engineVersion, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for RDS Custom DB Engine Version (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func modifyCustomDBEngineVersion(ctx context.Context, conn *rds.RDS, engine, engineVersion string, input *rds.ModifyCustomDBEngineVersionInput, timeout time.Duration) error {
+	input.Engine = aws.String(engine)
+	input.EngineVersion = aws.String(engineVersion)
+
+	if _, err := conn.ModifyCustomDBEngineVersionWithContext(ctx, input); err != nil {
+		return err
+	}
+
+	if _, err := waitCustomDBEngineVersionUpdated(ctx, conn, engine, engineVersion, timeout); err != nil {
+		return fmt.Errorf("waiting for update: %w", err)
+	}
+
+	return nil
+}
+
+func FindCustomDBEngineVersionByTwoPartKey(ctx context.Context, conn *rds.RDS, engine, engineVersion string) (*rds.DBEngineVersion, error) {
+	input := &rds.DescribeDBEngineVersionsInput{
+		Engine:        aws.String(engine),
+		EngineVersion: aws.String(engineVersion),
+		IncludeAll:    aws.Bool(true), // Required to return custom engine versions that are not available.
+	}
+
+	output, err := conn.DescribeDBEngineVersionsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeCustomDBEngineVersionNotFoundFault) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.DBEngineVersions) == 0 || output.DBEngineVersions[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.DBEngineVersions); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.DBEngineVersions[0], nil
+}
+
+func statusCustomDBEngineVersion(ctx context.Context, conn *rds.RDS, engine, engineVersion string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindCustomDBEngineVersionByTwoPartKey(ctx, conn, engine, engineVersion)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitCustomDBEngineVersionCreated(ctx context.Context, conn *rds.RDS, engine, engineVersion string, timeout time.Duration) (*rds.DBEngineVersion, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{CustomEngineVersionStatusCreating, CustomEngineVersionStatusValidating},
+		// RDS Custom for SQL Server engine versions remain pending validation until the first DB instance is created.
+		Target:     []string{CustomEngineVersionStatusAvailable, CustomEngineVersionStatusPendingValidation},
+		Refresh:    statusCustomDBEngineVersion(ctx, conn, engine, engineVersion),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      1 * time.Minute,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rds.DBEngineVersion); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitCustomDBEngineVersionUpdated(ctx context.Context, conn *rds.RDS, engine, engineVersion string, timeout time.Duration) (*rds.DBEngineVersion, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{CustomEngineVersionStatusCreating, CustomEngineVersionStatusValidating},
+		Target: []string{
+			CustomEngineVersionStatusAvailable,
+			CustomEngineVersionStatusInactive,
+			CustomEngineVersionStatusInactiveExceptRestore,
+			CustomEngineVersionStatusPendingValidation,
+		},
+		Refresh: statusCustomDBEngineVersion(ctx, conn, engine, engineVersion),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rds.DBEngineVersion); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitCustomDBEngineVersionDeleted(ctx context.Context, conn *rds.RDS, engine, engineVersion string, timeout time.Duration) (*rds.DBEngineVersion, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{CustomEngineVersionStatusDeleting},
+		Target:     []string{},
+		Refresh:    statusCustomDBEngineVersion(ctx, conn, engine, engineVersion),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rds.DBEngineVersion); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,229 @@
+package rds_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/rds"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfrds "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccRDSCustomDBEngineVersion_sqlServer(t *testing.T) {
+	ctx := acctest.Context(t)
+	key := "RDS_CUSTOM_WINDOWS_SQLSERVER_AMI"
+	ami := os.Getenv(key)
+	if ami == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	var v rds.DBEngineVersion
+	rName := fmt.Sprintf("%s%s%d", "15.00.4249.2.", acctest.ResourcePrefix, sdkacctest.RandIntRange(100, 999))
+	resourceName := "aws_rds_custom_db_engine_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomDBEngineVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomDBEngineVersionConfig_sqlServer(rName, ami),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomDBEngineVersionExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "rds", regexp.MustCompile(fmt.Sprintf(`cev:custom-sqlserver.*%s/.+`, rName))),
+					resource.TestCheckResourceAttrSet(resourceName, "create_time"),
+					resource.TestCheckResourceAttr(resourceName, "engine", "custom-sqlserver-se"),
+					resource.TestCheckResourceAttr(resourceName, "engine_version", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "image_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "major_engine_version"),
+					resource.TestCheckResourceAttr(resourceName, "source_image_id", ami),
+					resource.TestCheckResourceAttr(resourceName, "status", "pending-validation"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source_image_id"},
+			},
+		},
+	})
+}
+
+func TestAccRDSCustomDBEngineVersion_oracle(t *testing.T) {
+	ctx := acctest.Context(t)
+	key := "RDS_CUSTOM_ORACLE_S3_BUCKET"
+	bucket := os.Getenv(key)
+	if bucket == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	var v rds.DBEngineVersion
+	rName := fmt.Sprintf("%s%s%d", "19.19.ee.", acctest.ResourcePrefix, sdkacctest.RandIntRange(100, 999))
+	resourceName := "aws_rds_custom_db_engine_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomDBEngineVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomDBEngineVersionConfig_oracle(rName, bucket, "available"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomDBEngineVersionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "database_installation_files_s3_bucket_name", bucket),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+					resource.TestCheckResourceAttr(resourceName, "engine", "custom-oracle-ee"),
+					resource.TestCheckResourceAttrSet(resourceName, "manifest_computed"),
+					resource.TestCheckResourceAttr(resourceName, "status", "available"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"manifest"},
+			},
+			{
+				Config: testAccCustomDBEngineVersionConfig_oracle(rName, bucket, "inactive"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomDBEngineVersionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "status", "inactive"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSCustomDBEngineVersion_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	key := "RDS_CUSTOM_WINDOWS_SQLSERVER_AMI"
+	ami := os.Getenv(key)
+	if ami == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	var v rds.DBEngineVersion
+	rName := fmt.Sprintf("%s%s%d", "15.00.4249.2.", acctest.ResourcePrefix, sdkacctest.RandIntRange(100, 999))
+	resourceName := "aws_rds_custom_db_engine_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomDBEngineVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomDBEngineVersionConfig_sqlServer(rName, ami),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomDBEngineVersionExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfrds.ResourceCustomDBEngineVersion(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCustomDBEngineVersionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_rds_custom_db_engine_version" {
+				continue
+			}
+
+			_, err := tfrds.FindCustomDBEngineVersionByTwoPartKey(ctx, conn, rs.Primary.Attributes["engine"], rs.Primary.Attributes["engine_version"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("RDS Custom DB Engine Version %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCustomDBEngineVersionExists(ctx context.Context, n string, v *rds.DBEngineVersion) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No RDS Custom DB Engine Version ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn(ctx)
+
+		output, err := tfrds.FindCustomDBEngineVersionByTwoPartKey(ctx, conn, rs.Primary.Attributes["engine"], rs.Primary.Attributes["engine_version"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCustomDBEngineVersionConfig_sqlServer(rName, ami string) string {
+	return fmt.Sprintf(`
+resource "aws_rds_custom_db_engine_version" "test" {
+  engine          = "custom-sqlserver-se"
+  engine_version  = %[1]q
+  source_image_id = %[2]q
+}
+`, rName, ami)
+}
+
+func testAccCustomDBEngineVersionConfig_oracle(rName, bucket, status string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_rds_custom_db_engine_version" "test" {
+  database_installation_files_s3_bucket_name = %[2]q
+  description                                = "test"
+  engine                                     = "custom-oracle-ee"
+  engine_version                             = %[1]q
+  kms_key_id                                 = aws_kms_key.test.arn
+  status                                     = %[3]q
+
+  manifest = jsonencode({
+    mediaImportTemplateVersion = "2020-08-14"
+    databaseInstallationFileNames = [
+      "V982063-01.zip",
+    ]
+    opatchFileNames = [
+      "p6880880_190000_Linux-x86-64.zip",
+    ]
+    psuRuPatchFileNames = [
+      "p35042068_190000_Linux-x86-64.zip",
+    ]
+  })
+}
+`, rName, bucket, status)
+}
@@ -2,7 +2,8 @@ package rds
 
 // Exports for use in tests only.
 var (
-	FindDBInstanceByID = findDBInstanceByIDSDKv1
+	FindBlueGreenDeploymentByID = findBlueGreenDeploymentByID
+	FindDBInstanceByID          = findDBInstanceByIDSDKv1
 
 	ListTags = listTags
 )
@@ -753,6 +753,100 @@ func TestAccRDSInstance_deletionProtection(t *testing.T) {
 	})
 }
 
+func TestAccRDSInstance_dedicatedLogVolume(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var dbInstance rds.DBInstance
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_dedicatedLogVolume(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &dbInstance),
+					resource.TestCheckResourceAttr(resourceName, "dedicated_log_volume", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"apply_immediately",
+					"final_snapshot_identifier",
+					"password",
+					"skip_final_snapshot",
+					"delete_automated_backups",
+				},
+			},
+			{
+				Config: testAccInstanceConfig_dedicatedLogVolume(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &dbInstance),
+					resource.TestCheckResourceAttr(resourceName, "dedicated_log_volume", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSInstance_dedicatedLogVolumeNewIdentifier(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v1, v2, v3 rds.DBInstance
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_dedicatedLogVolumeApplyImmediately(rName, false, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "dedicated_log_volume", "false"),
+					resource.TestCheckResourceAttr(resourceName, "identifier", rName),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_dedicatedLogVolumeApplyImmediately(rName2, true, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &v2),
+					testAccCheckDBInstanceNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "identifier", rName),
+				),
+				ExpectNonEmptyPlan: true, // diff if apply_immediately = false
+			},
+			{
+				Config: testAccInstanceConfig_dedicatedLogVolumeApplyImmediately(rName2, true, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &v3),
+					testAccCheckDBInstanceNotRecreated(&v1, &v3),
+					resource.TestCheckResourceAttr(resourceName, "dedicated_log_volume", "true"),
+					resource.TestCheckResourceAttr(resourceName, "identifier", rName2),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRDSInstance_finalSnapshotIdentifier(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -7266,6 +7360,72 @@ resource "aws_db_instance" "test" {
 `, deletionProtection, rName))
 }
 
+func testAccInstanceConfig_dedicatedLogVolume(rName string, dedicatedLogVolume bool) string {
+	return fmt.Sprintf(`
+data "aws_rds_engine_version" "default" {
+  engine = "mysql"
+}
+
+data "aws_rds_orderable_db_instance" "test" {
+  engine                     = data.aws_rds_engine_version.default.engine
+  engine_version             = data.aws_rds_engine_version.default.version
+  license_model              = "general-public-license"
+  preferred_instance_classes = ["db.m6g.large", "db.m5.large", "db.r6g.large"]
+
+  storage_type  = "io1"
+  supports_iops = true
+}
+
+resource "aws_db_instance" "test" {
+  allocated_storage    = 1000
+  apply_immediately    = true
+  dedicated_log_volume = %[2]t
+  engine               = data.aws_rds_engine_version.default.engine
+  engine_version       = data.aws_rds_engine_version.default.version
+  identifier           = %[1]q
+  instance_class       = data.aws_rds_orderable_db_instance.test.instance_class
+  iops                 = 3000
+  password             = "avoid-plaintext-passwords"
+  skip_final_snapshot  = true
+  storage_type         = data.aws_rds_orderable_db_instance.test.storage_type
+  username             = "tfacctest"
+}
+`, rName, dedicatedLogVolume)
+}
+
+func testAccInstanceConfig_dedicatedLogVolumeApplyImmediately(rName string, dedicatedLogVolume, applyImmediately bool) string {
+	return fmt.Sprintf(`
+data "aws_rds_engine_version" "default" {
+  engine = "mysql"
+}
+
+data "aws_rds_orderable_db_instance" "test" {
+  engine                     = data.aws_rds_engine_version.default.engine
+  engine_version             = data.aws_rds_engine_version.default.version
+  license_model              = "general-public-license"
+  preferred_instance_classes = ["db.m6g.large", "db.m5.large", "db.r6g.large"]
+
+  storage_type  = "io1"
+  supports_iops = true
+}
+
+resource "aws_db_instance" "test" {
+  allocated_storage    = 1000
+  apply_immediately    = %[3]t
+  dedicated_log_volume = %[2]t
+  engine               = data.aws_rds_engine_version.default.engine
+  engine_version       = data.aws_rds_engine_version.default.version
+  identifier           = %[1]q
+  instance_class       = data.aws_rds_orderable_db_instance.test.instance_class
+  iops                 = 3000
+  password             = "avoid-plaintext-passwords"
+  skip_final_snapshot  = true
+  storage_type         = data.aws_rds_orderable_db_instance.test.storage_type
+  username             = "tfacctest"
+}
+`, rName, dedicatedLogVolume, applyImmediately)
+}
+
 func testAccInstanceConfig_EnabledCloudWatchLogsExports_oracle(rName string) string {
 	return fmt.Sprintf(`
 data "aws_rds_orderable_db_instance" "test" {
@@ -112,6 +112,7 @@ func ResourceRecord() *schema.Resource {
 				ConflictsWith: []string{
 					"failover_routing_policy",
 					"geolocation_routing_policy",
+					"geoproximity_routing_policy",
 					"latency_routing_policy",
 					"multivalue_answer_routing_policy",
 					"weighted_routing_policy",
@@ -134,6 +135,7 @@ func ResourceRecord() *schema.Resource {
 				ConflictsWith: []string{
 					"cidr_routing_policy",
 					"geolocation_routing_policy",
+					"geoproximity_routing_policy",
 					"latency_routing_policy",
 					"multivalue_answer_routing_policy",
 					"weighted_routing_policy",
@@ -167,6 +169,58 @@ func ResourceRecord() *schema.Resource {
 				ConflictsWith: []string{
 					"cidr_routing_policy",
 					"failover_routing_policy",
+					"geoproximity_routing_policy",
+					"latency_routing_policy",
+					"multivalue_answer_routing_policy",
+					"weighted_routing_policy",
+				},
+				RequiredWith: []string{"set_identifier"},
+			},
+			"geoproximity_routing_policy": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"aws_region": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ExactlyOneOf: []string{"geoproximity_routing_policy.0.aws_region", "geoproximity_routing_policy.0.coordinates", "geoproximity_routing_policy.0.local_zone_group"},
+						},
+						"bias": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(-99, 99),
+						},
+						"coordinates": {
+							Type:         schema.TypeSet,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"geoproximity_routing_policy.0.aws_region", "geoproximity_routing_policy.0.coordinates", "geoproximity_routing_policy.0.local_zone_group"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"latitude": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"longitude": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"local_zone_group": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ExactlyOneOf: []string{"geoproximity_routing_policy.0.aws_region", "geoproximity_routing_policy.0.coordinates", "geoproximity_routing_policy.0.local_zone_group"},
+						},
+					},
+				},
+				ConflictsWith: []string{
+					"cidr_routing_policy",
+					"failover_routing_policy",
+					"geolocation_routing_policy",
 					"latency_routing_policy",
 					"multivalue_answer_routing_policy",
 					"weighted_routing_policy",
@@ -193,6 +247,7 @@ func ResourceRecord() *schema.Resource {
 					"cidr_routing_policy",
 					"failover_routing_policy",
 					"geolocation_routing_policy",
+					"geoproximity_routing_policy",
 					"multivalue_answer_routing_policy",
 					"weighted_routing_policy",
 				},
@@ -205,6 +260,7 @@ func ResourceRecord() *schema.Resource {
 					"cidr_routing_policy",
 					"failover_routing_policy",
 					"geolocation_routing_policy",
+					"geoproximity_routing_policy",
 					"latency_routing_policy",
 					"weighted_routing_policy",
 				},
@@ -259,6 +315,7 @@ func ResourceRecord() *schema.Resource {
 					"cidr_routing_policy",
 					"failover_routing_policy",
 					"geolocation_routing_policy",
+					"geoproximity_routing_policy",
 					"latency_routing_policy",
 					"multivalue_answer_routing_policy",
 				},
@@ -406,6 +463,23 @@ func resourceRecordRead(ctx context.Context, d *schema.ResourceData, meta interf
 		}
 	}
 
+	if record.GeoProximityLocation != nil {
+		v := []map[string]interface{}{{
+			"aws_region":       aws.StringValue(record.GeoProximityLocation.AWSRegion),
+			"bias":             aws.Int64Value(record.GeoProximityLocation.Bias),
+			"local_zone_group": aws.StringValue(record.GeoProximityLocation.LocalZoneGroup),
+		}}
+		if coordinates := record.GeoProximityLocation.Coordinates; coordinates != nil {
+			v[0]["coordinates"] = []map[string]interface{}{{
+				"latitude":  aws.StringValue(coordinates.Latitude),
+				"longitude": aws.StringValue(coordinates.Longitude),
+			}}
+		}
+		if err := d.Set("geoproximity_routing_policy", v); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting geoproximity_routing_policy: %s", err)
+		}
+	}
+
 	if record.Region != nil {
 		v := []map[string]interface{}{{
 			"region": aws.StringValue(record.Region),
@@ -472,6 +546,7 @@ func resourceRecordUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 	// - cidr_routing_policy
 	// - failover_routing_policy
 	// - geolocation_routing_policy
+	// - geoproximity_routing_policy
 	// - latency_routing_policy
 	// - multivalue_answer_routing_policy
 	// - weighted_routing_policy
@@ -513,6 +588,16 @@ func resourceRecordUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		}
 	}
 
+	if v, _ := d.GetChange("geoproximity_routing_policy"); v != nil {
+		if o, ok := v.([]interface{}); ok {
+			if len(o) == 1 {
+				if v, ok := o[0].(map[string]interface{}); ok {
+					oldRec.GeoProximityLocation = expandGeoProximityLocation(v)
+				}
+			}
+		}
+	}
+
 	if v, _ := d.GetChange("latency_routing_policy"); v != nil {
 		if o, ok := v.([]interface{}); ok {
 			if len(o) == 1 {
@@ -847,6 +932,13 @@ func expandResourceRecordSet(d *schema.ResourceData, zoneName string) *route53.R
 		}
 	}
 
+	if v, ok := d.GetOk("geoproximity_routing_policy"); ok {
+		records := v.([]interface{})
+		geoproximity := records[0].(map[string]interface{})
+
+		rec.GeoProximityLocation = expandGeoProximityLocation(geoproximity)
+	}
+
 	if v, ok := d.GetOk("health_check_id"); ok {
 		rec.HealthCheckId = aws.String(v.(string))
 	}
@@ -876,6 +968,28 @@ func expandResourceRecordSet(d *schema.ResourceData, zoneName string) *route53.R
 	return rec
 }
 
+func expandGeoProximityLocation(tfMap map[string]interface{}) *route53.GeoProximityLocation {
+	apiObject := &route53.GeoProximityLocation{
+		AWSRegion:      nilString(tfMap["aws_region"].(string)),
+		LocalZoneGroup: nilString(tfMap["local_zone_group"].(string)),
+	}
+
+	if v, ok := tfMap["bias"].(int); ok && v != 0 {
+		apiObject.Bias = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["coordinates"].(*schema.Set); ok && v.Len() > 0 {
+		coordinates := v.List()[0].(map[string]interface{})
+
+		apiObject.Coordinates = &route53.Coordinates{
+			Latitude:  aws.String(coordinates["latitude"].(string)),
+			Longitude: aws.String(coordinates["longitude"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
 func FQDN(name string) string {
 	n := len(name)
 	if n == 0 || name[n-1] == '.' {
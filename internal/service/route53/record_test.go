@@ -941,6 +941,78 @@ func TestAccRoute53Record_Latency_basic(t *testing.T) {
 	})
 }
 
+func TestAccRoute53Record_Geoproximity_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var record1, record2, record3 route53.ResourceRecordSet
+	resourceName := "aws_route53_record.region"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordConfig_geoproximityCNAME(endpoints.UsEast1RegionID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordExists(ctx, resourceName, &record1),
+					testAccCheckRecordExists(ctx, "aws_route53_record.local_zone", &record2),
+					testAccCheckRecordExists(ctx, "aws_route53_record.coordinates", &record3),
+					resource.TestCheckResourceAttr(resourceName, "geoproximity_routing_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "geoproximity_routing_policy.0.aws_region", endpoints.UsEast1RegionID),
+					resource.TestCheckResourceAttr(resourceName, "geoproximity_routing_policy.0.bias", "40"),
+					resource.TestCheckResourceAttr("aws_route53_record.local_zone", "geoproximity_routing_policy.0.local_zone_group", "usw2-lax1"),
+					resource.TestCheckResourceAttr("aws_route53_record.coordinates", "geoproximity_routing_policy.0.coordinates.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("aws_route53_record.coordinates", "geoproximity_routing_policy.0.coordinates.*", map[string]string{
+						"latitude":  "49.22",
+						"longitude": "-74.01",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"allow_overwrite", "weight"},
+			},
+		},
+	})
+}
+
+func TestAccRoute53Record_SetIdentifierRename_geoproximity(t *testing.T) {
+	ctx := acctest.Context(t)
+	var record1, record2 route53.ResourceRecordSet
+	resourceName := "aws_route53_record.set_identifier_rename_geoproximity"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordConfig_setIdentifierRenameGeoproximity(endpoints.UsEast1RegionID, "before"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordExists(ctx, resourceName, &record1),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"allow_overwrite", "weight"},
+			},
+			{
+				Config: testAccRecordConfig_setIdentifierRenameGeoproximity(endpoints.UsEast1RegionID, "after"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordExists(ctx, resourceName, &record2),
+					resource.TestCheckResourceAttr(resourceName, "set_identifier", "after"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRoute53Record_typeChange(t *testing.T) {
 	ctx := acctest.Context(t)
 	var record1, record2 route53.ResourceRecordSet
@@ -2083,6 +2155,60 @@ resource "aws_route53_record" "denmark" {
 }
 `
 
+func testAccRecordConfig_geoproximityCNAME(region string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "main" {
+  name = "domain.test"
+}
+
+resource "aws_route53_record" "region" {
+  zone_id = aws_route53_zone.main.zone_id
+  name    = "www"
+  type    = "CNAME"
+  ttl     = "5"
+
+  geoproximity_routing_policy {
+    aws_region = %[1]q
+    bias       = 40
+  }
+
+  set_identifier = "region"
+  records        = ["dev.domain.test"]
+}
+
+resource "aws_route53_record" "local_zone" {
+  zone_id = aws_route53_zone.main.zone_id
+  name    = "www"
+  type    = "CNAME"
+  ttl     = "5"
+
+  geoproximity_routing_policy {
+    local_zone_group = "usw2-lax1"
+  }
+
+  set_identifier = "local-zone"
+  records        = ["dev.domain.test"]
+}
+
+resource "aws_route53_record" "coordinates" {
+  zone_id = aws_route53_zone.main.zone_id
+  name    = "www"
+  type    = "CNAME"
+  ttl     = "5"
+
+  geoproximity_routing_policy {
+    coordinates {
+      latitude  = "49.22"
+      longitude = "-74.01"
+    }
+  }
+
+  set_identifier = "coordinates"
+  records        = ["dev.domain.test"]
+}
+`, region)
+}
+
 func testAccRecordConfig_latencyCNAME(firstRegion, secondRegion, thirdRegion string) string {
 	return fmt.Sprintf(`
 resource "aws_route53_zone" "main" {
@@ -3049,3 +3175,25 @@ resource "aws_route53_record" "www-server1" {
   records = ["127.0.0.1"]
 }
 `
+
+func testAccRecordConfig_setIdentifierRenameGeoproximity(region, set_identifier string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "main" {
+  name = "domain.test"
+}
+
+resource "aws_route53_record" "set_identifier_rename_geoproximity" {
+  zone_id = aws_route53_zone.main.zone_id
+  name    = "www"
+  type    = "CNAME"
+  ttl     = "5"
+
+  geoproximity_routing_policy {
+    aws_region = %[1]q
+  }
+
+  set_identifier = %[2]q
+  records        = ["dev.domain.test"]
+}
+`, region, set_identifier)
+}
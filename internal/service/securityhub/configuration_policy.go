@@ -0,0 +1,541 @@
+package securityhub
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_securityhub_configuration_policy", name="Configuration Policy")
+// @Tags(identifierAttribute="arn")
+func ResourceConfigurationPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceConfigurationPolicyCreate,
+		ReadWithoutTimeout:   resourceConfigurationPolicyRead,
+		UpdateWithoutTimeout: resourceConfigurationPolicyUpdate,
+		DeleteWithoutTimeout: resourceConfigurationPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configuration_policy": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled_standard_arns": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidARN,
+							},
+						},
+						"security_controls_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"disabled_control_identifiers": {
+										Type:          schema.TypeSet,
+										Optional:      true,
+										Elem:          &schema.Schema{Type: schema.TypeString},
+										ConflictsWith: []string{"configuration_policy.0.security_controls_configuration.0.enabled_control_identifiers"},
+									},
+									"enabled_control_identifiers": {
+										Type:          schema.TypeSet,
+										Optional:      true,
+										Elem:          &schema.Schema{Type: schema.TypeString},
+										ConflictsWith: []string{"configuration_policy.0.security_controls_configuration.0.disabled_control_identifiers"},
+									},
+									"security_control_custom_parameter": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"parameter": {
+													Type:     schema.TypeSet,
+													Required: true,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"bool": {
+																Type:     schema.TypeList,
+																Optional: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"value": {
+																			Type:     schema.TypeBool,
+																			Required: true,
+																		},
+																	},
+																},
+															},
+															"double": {
+																Type:     schema.TypeList,
+																Optional: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"value": {
+																			Type:     schema.TypeFloat,
+																			Required: true,
+																		},
+																	},
+																},
+															},
+															"enum": {
+																Type:     schema.TypeList,
+																Optional: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"value": {
+																			Type:     schema.TypeString,
+																			Required: true,
+																		},
+																	},
+																},
+															},
+															"enum_list": {
+																Type:     schema.TypeList,
+																Optional: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"value": {
+																			Type:     schema.TypeList,
+																			Required: true,
+																			Elem:     &schema.Schema{Type: schema.TypeString},
+																		},
+																	},
+																},
+															},
+															"int": {
+																Type:     schema.TypeList,
+																Optional: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"value": {
+																			Type:     schema.TypeInt,
+																			Required: true,
+																		},
+																	},
+																},
+															},
+															"int_list": {
+																Type:     schema.TypeList,
+																Optional: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"value": {
+																			Type:     schema.TypeList,
+																			Required: true,
+																			Elem:     &schema.Schema{Type: schema.TypeInt},
+																		},
+																	},
+																},
+															},
+															"name": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+															"string": {
+																Type:     schema.TypeList,
+																Optional: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"value": {
+																			Type:     schema.TypeString,
+																			Required: true,
+																		},
+																	},
+																},
+															},
+															"string_list": {
+																Type:     schema.TypeList,
+																Optional: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"value": {
+																			Type:     schema.TypeList,
+																			Required: true,
+																			Elem:     &schema.Schema{Type: schema.TypeString},
+																		},
+																	},
+																},
+															},
+															"value_type": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringInSlice(securityhub.ParameterValueType_Values(), false),
+															},
+														},
+													},
+												},
+												"security_control_id": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"service_enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceConfigurationPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityHubConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &securityhub.CreateConfigurationPolicyInput{
+		ConfigurationPolicy: expandPolicy(d.Get("configuration_policy").([]interface{})),
+		Name:                aws.String(name),
+		Tags:                getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateConfigurationPolicyWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Security Hub Configuration Policy (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Id))
+
+	return resourceConfigurationPolicyRead(ctx, d, meta)
+}
+
+func resourceConfigurationPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityHubConn(ctx)
+
+	output, err := FindConfigurationPolicyByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Security Hub Configuration Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Security Hub Configuration Policy (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	if err := d.Set("configuration_policy", flattenPolicy(output.ConfigurationPolicy)); err != nil {
+		return diag.Errorf("setting configuration_policy: %s", err)
+	}
+	d.Set("description", output.Description)
+	d.Set("name", output.Name)
+
+	return nil
+}
+
+func resourceConfigurationPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityHubConn(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &securityhub.UpdateConfigurationPolicyInput{
+			ConfigurationPolicy: expandPolicy(d.Get("configuration_policy").([]interface{})),
+			Description:         aws.String(d.Get("description").(string)),
+			Identifier:          aws.String(d.Id()),
+			Name:                aws.String(d.Get("name").(string)),
+		}
+
+		_, err := conn.UpdateConfigurationPolicyWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Security Hub Configuration Policy (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceConfigurationPolicyRead(ctx, d, meta)
+}
+
+func resourceConfigurationPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityHubConn(ctx)
+
+	log.Printf("[DEBUG] Deleting Security Hub Configuration Policy: %s", d.Id())
+	_, err := conn.DeleteConfigurationPolicyWithContext(ctx, &securityhub.DeleteConfigurationPolicyInput{
+		Identifier: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, securityhub.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Security Hub Configuration Policy (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandPolicy(tfList []interface{}) *securityhub.Policy {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &securityhub.SecurityHubPolicy{
+		ServiceEnabled: aws.Bool(tfMap["service_enabled"].(bool)),
+	}
+
+	if v, ok := tfMap["enabled_standard_arns"].(*schema.Set); ok {
+		apiObject.EnabledStandardIdentifiers = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["security_controls_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SecurityControlsConfiguration = expandSecurityControlsConfiguration(v[0].(map[string]interface{}))
+	}
+
+	return &securityhub.Policy{
+		SecurityHub: apiObject,
+	}
+}
+
+func expandSecurityControlsConfiguration(tfMap map[string]interface{}) *securityhub.SecurityControlsConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &securityhub.SecurityControlsConfiguration{}
+
+	// Exactly one of the identifier lists must be sent, even when it is empty.
+	if v, ok := tfMap["disabled_control_identifiers"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.DisabledSecurityControlIdentifiers = flex.ExpandStringSet(v)
+	} else if v, ok := tfMap["enabled_control_identifiers"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.EnabledSecurityControlIdentifiers = flex.ExpandStringSet(v)
+	} else {
+		apiObject.DisabledSecurityControlIdentifiers = []*string{}
+	}
+
+	if v, ok := tfMap["security_control_custom_parameter"].([]interface{}); ok && len(v) > 0 {
+		apiObject.SecurityControlCustomParameters = expandSecurityControlCustomParameters(v)
+	}
+
+	return apiObject
+}
+
+func expandSecurityControlCustomParameters(tfList []interface{}) []*securityhub.SecurityControlCustomParameter {
+	var apiObjects []*securityhub.SecurityControlCustomParameter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &securityhub.SecurityControlCustomParameter{
+			Parameters:        map[string]*securityhub.ParameterConfiguration{},
+			SecurityControlId: aws.String(tfMap["security_control_id"].(string)),
+		}
+
+		if v, ok := tfMap["parameter"].(*schema.Set); ok {
+			for _, tfMapRaw := range v.List() {
+				tfMap, ok := tfMapRaw.(map[string]interface{})
+
+				if !ok {
+					continue
+				}
+
+				apiObject.Parameters[tfMap["name"].(string)] = expandParameterConfiguration(tfMap)
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandParameterConfiguration(tfMap map[string]interface{}) *securityhub.ParameterConfiguration {
+	apiObject := &securityhub.ParameterConfiguration{
+		ValueType: aws.String(tfMap["value_type"].(string)),
+	}
+
+	value := &securityhub.ParameterValue{}
+	hasValue := true
+
+	if v := parameterValueBlock(tfMap, "bool"); v != nil {
+		value.Boolean = aws.Bool(v["value"].(bool))
+	} else if v := parameterValueBlock(tfMap, "double"); v != nil {
+		value.Double = aws.Float64(v["value"].(float64))
+	} else if v := parameterValueBlock(tfMap, "enum"); v != nil {
+		value.Enum = aws.String(v["value"].(string))
+	} else if v := parameterValueBlock(tfMap, "enum_list"); v != nil {
+		value.EnumList = flex.ExpandStringList(v["value"].([]interface{}))
+	} else if v := parameterValueBlock(tfMap, "int"); v != nil {
+		value.Integer = aws.Int64(int64(v["value"].(int)))
+	} else if v := parameterValueBlock(tfMap, "int_list"); v != nil {
+		for _, v := range v["value"].([]interface{}) {
+			value.IntegerList = append(value.IntegerList, aws.Int64(int64(v.(int))))
+		}
+	} else if v := parameterValueBlock(tfMap, "string"); v != nil {
+		value.String_ = aws.String(v["value"].(string))
+	} else if v := parameterValueBlock(tfMap, "string_list"); v != nil {
+		value.StringList = flex.ExpandStringList(v["value"].([]interface{}))
+	} else {
+		hasValue = false
+	}
+
+	if hasValue {
+		apiObject.Value = value
+	}
+
+	return apiObject
+}
+
+func parameterValueBlock(tfMap map[string]interface{}, key string) map[string]interface{} {
+	if v, ok := tfMap[key].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		return v[0].(map[string]interface{})
+	}
+
+	return nil
+}
+
+func flattenPolicy(apiObject *securityhub.Policy) []interface{} {
+	if apiObject == nil || apiObject.SecurityHub == nil {
+		return nil
+	}
+
+	policy := apiObject.SecurityHub
+	tfMap := map[string]interface{}{
+		"enabled_standard_arns": aws.StringValueSlice(policy.EnabledStandardIdentifiers),
+		"service_enabled":       aws.BoolValue(policy.ServiceEnabled),
+	}
+
+	if v := policy.SecurityControlsConfiguration; v != nil {
+		tfMap["security_controls_configuration"] = []interface{}{flattenSecurityControlsConfiguration(v)}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenSecurityControlsConfiguration(apiObject *securityhub.SecurityControlsConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"disabled_control_identifiers": aws.StringValueSlice(apiObject.DisabledSecurityControlIdentifiers),
+		"enabled_control_identifiers":  aws.StringValueSlice(apiObject.EnabledSecurityControlIdentifiers),
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObject.SecurityControlCustomParameters {
+		if apiObject == nil {
+			continue
+		}
+
+		var parameters []interface{}
+
+		for name, apiObject := range apiObject.Parameters {
+			if apiObject == nil {
+				continue
+			}
+
+			parameters = append(parameters, flattenParameterConfiguration(name, apiObject))
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"parameter":           parameters,
+			"security_control_id": aws.StringValue(apiObject.SecurityControlId),
+		})
+	}
+
+	tfMap["security_control_custom_parameter"] = tfList
+
+	return tfMap
+}
+
+func flattenParameterConfiguration(name string, apiObject *securityhub.ParameterConfiguration) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"name":       name,
+		"value_type": aws.StringValue(apiObject.ValueType),
+	}
+
+	value := apiObject.Value
+
+	if value == nil {
+		return tfMap
+	}
+
+	switch {
+	case value.Boolean != nil:
+		tfMap["bool"] = []interface{}{map[string]interface{}{"value": aws.BoolValue(value.Boolean)}}
+	case value.Double != nil:
+		tfMap["double"] = []interface{}{map[string]interface{}{"value": aws.Float64Value(value.Double)}}
+	case value.Enum != nil:
+		tfMap["enum"] = []interface{}{map[string]interface{}{"value": aws.StringValue(value.Enum)}}
+	case value.EnumList != nil:
+		tfMap["enum_list"] = []interface{}{map[string]interface{}{"value": aws.StringValueSlice(value.EnumList)}}
+	case value.Integer != nil:
+		tfMap["int"] = []interface{}{map[string]interface{}{"value": aws.Int64Value(value.Integer)}}
+	case value.IntegerList != nil:
+		var tfList []interface{}
+		for _, v := range value.IntegerList {
+			tfList = append(tfList, int(aws.Int64Value(v)))
+		}
+		tfMap["int_list"] = []interface{}{map[string]interface{}{"value": tfList}}
+	case value.String_ != nil:
+		tfMap["string"] = []interface{}{map[string]interface{}{"value": aws.StringValue(value.String_)}}
+	case value.StringList != nil:
+		tfMap["string_list"] = []interface{}{map[string]interface{}{"value": aws.StringValueSlice(value.StringList)}}
+	}
+
+	return tfMap
+}
@@ -94,3 +94,124 @@ func FindStandardsControlByStandardsSubscriptionARNAndStandardsControlARN(ctx co
 
 	return output, nil
 }
+
+func FindAutomationRuleByARN(ctx context.Context, conn *securityhub.SecurityHub, arn string) (*securityhub.AutomationRulesConfig, error) {
+	input := &securityhub.BatchGetAutomationRulesInput{
+		AutomationRulesArns: aws.StringSlice([]string{arn}),
+	}
+
+	output, err := conn.BatchGetAutomationRulesWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, securityhub.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Rules) == 0 || output.Rules[0] == nil {
+		// Missing rules are reported as unprocessed rather than as an error.
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.Rules); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.Rules[0], nil
+}
+
+func FindOrganizationConfiguration(ctx context.Context, conn *securityhub.SecurityHub) (*securityhub.DescribeOrganizationConfigurationOutput, error) {
+	input := &securityhub.DescribeOrganizationConfigurationInput{}
+
+	output, err := conn.DescribeOrganizationConfigurationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, securityhub.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindConfigurationPolicyByID(ctx context.Context, conn *securityhub.SecurityHub, id string) (*securityhub.GetConfigurationPolicyOutput, error) {
+	input := &securityhub.GetConfigurationPolicyInput{
+		Identifier: aws.String(id),
+	}
+
+	output, err := conn.GetConfigurationPolicyWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, securityhub.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ConfigurationPolicy == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindConfigurationPolicyAssociationByTargetID(ctx context.Context, conn *securityhub.SecurityHub, targetID string) (*securityhub.GetConfigurationPolicyAssociationOutput, error) {
+	input := &securityhub.GetConfigurationPolicyAssociationInput{
+		Target: expandConfigurationPolicyAssociationTarget(targetID),
+	}
+
+	output, err := findConfigurationPolicyAssociation(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// A target that isn't directly associated reports the configuration inherited from its parent.
+	if associationType := aws.StringValue(output.AssociationType); associationType != securityhub.AssociationTypeApplied {
+		return nil, &retry.NotFoundError{
+			Message:     associationType,
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func findConfigurationPolicyAssociation(ctx context.Context, conn *securityhub.SecurityHub, input *securityhub.GetConfigurationPolicyAssociationInput) (*securityhub.GetConfigurationPolicyAssociationOutput, error) {
+	output, err := conn.GetConfigurationPolicyAssociationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, securityhub.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
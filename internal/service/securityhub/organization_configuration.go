@@ -2,14 +2,19 @@ package securityhub
 
 import (
 	"context"
+	"fmt"
+	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 // @SDKResource("aws_securityhub_organization_configuration")
@@ -18,12 +23,18 @@ func ResourceOrganizationConfiguration() *schema.Resource {
 		CreateWithoutTimeout: resourceOrganizationConfigurationUpdate,
 		ReadWithoutTimeout:   resourceOrganizationConfigurationRead,
 		UpdateWithoutTimeout: resourceOrganizationConfigurationUpdate,
-		DeleteWithoutTimeout: schema.NoopContext,
+		DeleteWithoutTimeout: resourceOrganizationConfigurationDelete,
 
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"auto_enable": {
 				Type:     schema.TypeBool,
@@ -35,7 +46,24 @@ func ResourceOrganizationConfiguration() *schema.Resource {
 				Computed:     true,
 				ValidateFunc: validation.StringInSlice(securityhub.AutoEnableStandards_Values(), false),
 			},
+			"organization_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"configuration_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(securityhub.OrganizationConfigurationConfigurationType_Values(), false),
+						},
+					},
+				},
+			},
 		},
+
+		CustomizeDiff: resourceOrganizationConfigurationCustomizeDiff,
 	}
 }
 
@@ -51,6 +79,10 @@ func resourceOrganizationConfigurationUpdate(ctx context.Context, d *schema.Reso
 		input.AutoEnableStandards = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("organization_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.OrganizationConfiguration = expandOrganizationConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	_, err := conn.UpdateOrganizationConfigurationWithContext(ctx, input)
 
 	if err != nil {
@@ -61,6 +93,15 @@ func resourceOrganizationConfigurationUpdate(ctx context.Context, d *schema.Reso
 		d.SetId(meta.(*conns.AWSClient).AccountID)
 	}
 
+	timeout := d.Timeout(schema.TimeoutCreate)
+	if !d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutUpdate)
+	}
+
+	if _, err := waitOrganizationConfigurationEnabled(ctx, conn, timeout); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Security Hub Organization Configuration (%s) update: %s", d.Id(), err)
+	}
+
 	return append(diags, resourceOrganizationConfigurationRead(ctx, d, meta)...)
 }
 
@@ -68,7 +109,13 @@ func resourceOrganizationConfigurationRead(ctx context.Context, d *schema.Resour
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SecurityHubConn(ctx)
 
-	output, err := conn.DescribeOrganizationConfigurationWithContext(ctx, &securityhub.DescribeOrganizationConfigurationInput{})
+	output, err := FindOrganizationConfiguration(ctx, conn)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Security Hub Organization Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading Security Hub Organization Configuration: %s", err)
@@ -76,6 +123,103 @@ func resourceOrganizationConfigurationRead(ctx context.Context, d *schema.Resour
 
 	d.Set("auto_enable", output.AutoEnable)
 	d.Set("auto_enable_standards", output.AutoEnableStandards)
+	if err := d.Set("organization_configuration", flattenOrganizationConfiguration(output.OrganizationConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting organization_configuration: %s", err)
+	}
+
+	return diags
+}
+
+func resourceOrganizationConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityHubConn(ctx)
+
+	// The organization configuration can't be deleted. Only revert central configuration, which otherwise
+	// can't be turned off without this resource.
+	if !isCentralOrganizationConfiguration(d.Get("organization_configuration").([]interface{})) {
+		return diags
+	}
+
+	input := &securityhub.UpdateOrganizationConfigurationInput{
+		AutoEnable: aws.Bool(false),
+		OrganizationConfiguration: &securityhub.OrganizationConfiguration{
+			ConfigurationType: aws.String(securityhub.OrganizationConfigurationConfigurationTypeLocal),
+		},
+	}
+
+	log.Printf("[DEBUG] Reverting Security Hub Organization Configuration (%s) to local configuration", d.Id())
+	_, err := conn.UpdateOrganizationConfigurationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, securityhub.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Security Hub Organization Configuration (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitOrganizationConfigurationEnabled(ctx, conn, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Security Hub Organization Configuration (%s) delete: %s", d.Id(), err)
+	}
 
 	return diags
 }
+
+func resourceOrganizationConfigurationCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !isCentralOrganizationConfiguration(d.Get("organization_configuration").([]interface{})) {
+		return nil
+	}
+
+	if d.Get("auto_enable").(bool) {
+		return fmt.Errorf(`"auto_enable" must be false when "configuration_type" is %q`, securityhub.OrganizationConfigurationConfigurationTypeCentral)
+	}
+
+	if d.NewValueKnown("auto_enable_standards") {
+		if v := d.Get("auto_enable_standards").(string); v != securityhub.AutoEnableStandardsNone {
+			return fmt.Errorf(`"auto_enable_standards" must be %q when "configuration_type" is %q`, securityhub.AutoEnableStandardsNone, securityhub.OrganizationConfigurationConfigurationTypeCentral)
+		}
+	}
+
+	return nil
+}
+
+func isCentralOrganizationConfiguration(tfList []interface{}) bool {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return false
+	}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+	if !ok {
+		return false
+	}
+
+	v, _ := tfMap["configuration_type"].(string)
+
+	return v == securityhub.OrganizationConfigurationConfigurationTypeCentral
+}
+
+func expandOrganizationConfiguration(tfMap map[string]interface{}) *securityhub.OrganizationConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &securityhub.OrganizationConfiguration{}
+
+	if v, ok := tfMap["configuration_type"].(string); ok && v != "" {
+		apiObject.ConfigurationType = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenOrganizationConfiguration(apiObject *securityhub.OrganizationConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"configuration_type": aws.StringValue(apiObject.ConfigurationType),
+	}
+
+	return []interface{}{tfMap}
+}
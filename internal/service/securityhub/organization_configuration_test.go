@@ -3,13 +3,16 @@ package securityhub_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/securityhub"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsecurityhub "github.com/hashicorp/terraform-provider-aws/internal/service/securityhub"
 )
 
 func testAccOrganizationConfiguration_basic(t *testing.T) {
@@ -82,6 +85,68 @@ func testAccOrganizationConfiguration_autoEnableStandards(t *testing.T) {
 	})
 }
 
+func testAccOrganizationConfiguration_centralConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_securityhub_organization_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckOrganizationManagementAccount(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, securityhub.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccOrganizationConfigurationConfig_centralConfiguration(true, "NONE", "CENTRAL"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`"auto_enable" must be false`),
+			},
+			{
+				Config:      testAccOrganizationConfigurationConfig_centralConfiguration(false, "DEFAULT", "CENTRAL"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`"auto_enable_standards" must be "NONE"`),
+			},
+			{
+				Config: testAccOrganizationConfigurationConfig_centralConfiguration(false, "NONE", "CENTRAL"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccOrganizationConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable", "false"),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable_standards", "NONE"),
+					resource.TestCheckResourceAttr(resourceName, "organization_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "organization_configuration.0.configuration_type", "CENTRAL"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccOrganizationConfigurationConfig_centralConfiguration(true, "DEFAULT", "LOCAL"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccOrganizationConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable", "true"),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable_standards", "DEFAULT"),
+					resource.TestCheckResourceAttr(resourceName, "organization_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "organization_configuration.0.configuration_type", "LOCAL"),
+				),
+			},
+			{
+				Config: testAccOrganizationConfigurationConfig_centralConfiguration(false, "NONE", "CENTRAL"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "organization_configuration.0.configuration_type", "CENTRAL"),
+				),
+			},
+			{
+				// Removing the resource reverts the organization to local configuration.
+				Config: testAccOrganizationConfigurationConfig_findingAggregator,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrganizationConfigurationType(ctx, "LOCAL"),
+				),
+			},
+		},
+	})
+}
+
 func testAccOrganizationConfigurationExists(ctx context.Context, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		_, ok := s.RootModule().Resources[n]
@@ -97,6 +162,28 @@ func testAccOrganizationConfigurationExists(ctx context.Context, n string) resou
 	}
 }
 
+func testAccCheckOrganizationConfigurationType(ctx context.Context, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityHubConn(ctx)
+
+		output, err := tfsecurityhub.FindOrganizationConfiguration(ctx, conn)
+
+		if err != nil {
+			return err
+		}
+
+		if output.OrganizationConfiguration == nil {
+			return fmt.Errorf("Security Hub Organization Configuration has no configuration type")
+		}
+
+		if actual := aws.StringValue(output.OrganizationConfiguration.ConfigurationType); actual != expected {
+			return fmt.Errorf("Security Hub Organization Configuration type is %q, expected %q", actual, expected)
+		}
+
+		return nil
+	}
+}
+
 const testAccOrganizationConfigurationConfig_base = `
 resource "aws_securityhub_account" "test" {}
 
@@ -129,3 +216,26 @@ resource "aws_securityhub_organization_configuration" "test" {
 }
 `, autoEnableStandards))
 }
+
+var testAccOrganizationConfigurationConfig_findingAggregator = acctest.ConfigCompose(testAccOrganizationConfigurationConfig_base, `
+resource "aws_securityhub_finding_aggregator" "test" {
+  linking_mode = "ALL_REGIONS"
+
+  depends_on = [aws_securityhub_organization_admin_account.test]
+}
+`)
+
+func testAccOrganizationConfigurationConfig_centralConfiguration(autoEnable bool, autoEnableStandards, configurationType string) string {
+	return acctest.ConfigCompose(testAccOrganizationConfigurationConfig_findingAggregator, fmt.Sprintf(`
+resource "aws_securityhub_organization_configuration" "test" {
+  auto_enable           = %[1]t
+  auto_enable_standards = %[2]q
+
+  organization_configuration {
+    configuration_type = %[3]q
+  }
+
+  depends_on = [aws_securityhub_finding_aggregator.test]
+}
+`, autoEnable, autoEnableStandards, configurationType))
+}
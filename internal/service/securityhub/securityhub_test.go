@@ -17,6 +17,20 @@ func TestAccSecurityHub_serial(t *testing.T) {
 			"MigrateV0":                   testAccAccount_migrateV0,
 			"full":                        testAccAccount_full,
 		},
+		"AutomationRule": {
+			"basic":      testAccAutomationRule_basic,
+			"disappears": testAccAutomationRule_disappears,
+			"full":       testAccAutomationRule_full,
+			"tags":       testAccAutomationRule_tags,
+		},
+		"ConfigurationPolicy": {
+			"basic":            testAccConfigurationPolicy_basic,
+			"disappears":       testAccConfigurationPolicy_disappears,
+			"CustomParameters": testAccConfigurationPolicy_customParameters,
+		},
+		"ConfigurationPolicyAssociation": {
+			"basic": testAccConfigurationPolicyAssociation_basic,
+		},
 		"Member": {
 			"basic":  testAccMember_basic,
 			"invite": testAccMember_invite,
@@ -49,8 +63,9 @@ func TestAccSecurityHub_serial(t *testing.T) {
 			"MultiRegion": testAccOrganizationAdminAccount_MultiRegion,
 		},
 		"OrganizationConfiguration": {
-			"basic":               testAccOrganizationConfiguration_basic,
-			"AutoEnableStandards": testAccOrganizationConfiguration_autoEnableStandards,
+			"basic":                testAccOrganizationConfiguration_basic,
+			"AutoEnableStandards":  testAccOrganizationConfiguration_autoEnableStandards,
+			"CentralConfiguration": testAccOrganizationConfiguration_centralConfiguration,
 		},
 		"ProductSubscription": {
 			"basic": testAccProductSubscription_basic,
@@ -6,6 +6,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/securityhub"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -32,3 +33,63 @@ func statusAdminAccountAdmin(ctx context.Context, conn *securityhub.SecurityHub,
 		return adminAccount, aws.StringValue(adminAccount.Status), nil
 	}
 }
+
+func statusOrganizationConfiguration(ctx context.Context, conn *securityhub.SecurityHub) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindOrganizationConfiguration(ctx, conn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.OrganizationConfiguration == nil {
+			// Accounts that have never opted into central configuration report no status.
+			return output, securityhub.OrganizationConfigurationStatusEnabled, nil
+		}
+
+		return output, aws.StringValue(output.OrganizationConfiguration.Status), nil
+	}
+}
+
+func statusConfigurationPolicyAssociation(ctx context.Context, conn *securityhub.SecurityHub, targetID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindConfigurationPolicyAssociationByTargetID(ctx, conn, targetID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.AssociationStatus), nil
+	}
+}
+
+func statusConfigurationPolicyDisassociation(ctx context.Context, conn *securityhub.SecurityHub, targetID, policyID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findConfigurationPolicyAssociation(ctx, conn, &securityhub.GetConfigurationPolicyAssociationInput{
+			Target: expandConfigurationPolicyAssociationTarget(targetID),
+		})
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		// The target still reports the disassociated policy until the disassociation has started.
+		if aws.StringValue(output.AssociationType) == securityhub.AssociationTypeApplied && aws.StringValue(output.ConfigurationPolicyId) == policyID {
+			return output, securityhub.ConfigurationPolicyAssociationStatusPending, nil
+		}
+
+		return output, aws.StringValue(output.AssociationStatus), nil
+	}
+}
@@ -2,10 +2,13 @@ package securityhub
 
 import (
 	"context"
+	"errors"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/securityhub"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -51,3 +54,62 @@ func waitAdminAccountNotFound(ctx context.Context, conn *securityhub.SecurityHub
 
 	return nil, err
 }
+
+func waitOrganizationConfigurationEnabled(ctx context.Context, conn *securityhub.SecurityHub, timeout time.Duration) (*securityhub.DescribeOrganizationConfigurationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{securityhub.OrganizationConfigurationStatusPending},
+		Target:  []string{securityhub.OrganizationConfigurationStatusEnabled},
+		Refresh: statusOrganizationConfiguration(ctx, conn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*securityhub.DescribeOrganizationConfigurationOutput); ok {
+		if v := output.OrganizationConfiguration; v != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(v.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitConfigurationPolicyAssociationSucceeded(ctx context.Context, conn *securityhub.SecurityHub, targetID string, timeout time.Duration) (*securityhub.GetConfigurationPolicyAssociationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{securityhub.ConfigurationPolicyAssociationStatusPending},
+		Target:  []string{securityhub.ConfigurationPolicyAssociationStatusSuccess},
+		Refresh: statusConfigurationPolicyAssociation(ctx, conn, targetID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*securityhub.GetConfigurationPolicyAssociationOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.AssociationStatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitConfigurationPolicyDisassociationSucceeded(ctx context.Context, conn *securityhub.SecurityHub, targetID, policyID string, timeout time.Duration) (*securityhub.GetConfigurationPolicyAssociationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{securityhub.ConfigurationPolicyAssociationStatusPending},
+		Target:  []string{securityhub.ConfigurationPolicyAssociationStatusSuccess},
+		Refresh: statusConfigurationPolicyDisassociation(ctx, conn, targetID, policyID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*securityhub.GetConfigurationPolicyAssociationOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.AssociationStatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
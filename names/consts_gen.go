@@ -10,7 +10,6 @@ const (
 	APIGatewayV2                 = "apigatewayv2"
 	AccessAnalyzer               = "accessanalyzer"
 	Account                      = "account"
-	AlexaForBusiness             = "alexaforbusiness"
 	Amplify                      = "amplify"
 	AmplifyBackend               = "amplifybackend"
 	AmplifyUIBuilder             = "amplifyuibuilder"
@@ -49,6 +48,7 @@ const (
 	CloudDirectory               = "clouddirectory"
 	CloudFormation               = "cloudformation"
 	CloudFront                   = "cloudfront"
+	CloudFrontKeyValueStore      = "cloudfrontkeyvaluestore"
 	CloudHSMV2                   = "cloudhsmv2"
 	CloudSearch                  = "cloudsearch"
 	CloudSearchDomain            = "cloudsearchdomain"
@@ -134,7 +134,6 @@ const (
 	GuardDuty                    = "guardduty"
 	Health                       = "health"
 	HealthLake                   = "healthlake"
-	Honeycode                    = "honeycode"
 	IAM                          = "iam"
 	IVS                          = "ivs"
 	IVSChat                      = "ivschat"
@@ -187,7 +186,6 @@ const (
 	MTurk                        = "mturk"
 	MWAA                         = "mwaa"
 	MachineLearning              = "machinelearning"
-	Macie                        = "macie"
 	Macie2                       = "macie2"
 	ManagedBlockchain            = "managedblockchain"
 	MarketplaceCatalog           = "marketplacecatalog"
@@ -208,7 +206,6 @@ const (
 	MigrationHubConfig           = "migrationhubconfig"
 	MigrationHubRefactorSpaces   = "migrationhubrefactorspaces"
 	MigrationHubStrategy         = "migrationhubstrategy"
-	Mobile                       = "mobile"
 	Neptune                      = "neptune"
 	NetworkFirewall              = "networkfirewall"
 	NetworkManager               = "networkmanager"